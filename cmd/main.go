@@ -4,36 +4,490 @@ package main
 
 import (
 	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-number-guessing-game/internal/achievement"
+	"github.com/go-number-guessing-game/internal/asciicast"
 	"github.com/go-number-guessing-game/internal/cli"
 	"github.com/go-number-guessing-game/internal/config"
 	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/go-number-guessing-game/internal/oracle"
+	"github.com/go-number-guessing-game/internal/parser"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/protocol"
+	"github.com/go-number-guessing-game/internal/rating"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/go-number-guessing-game/internal/replay"
+	"github.com/go-number-guessing-game/internal/save"
+	"github.com/go-number-guessing-game/internal/scoring"
+	"github.com/go-number-guessing-game/internal/season"
 	"github.com/go-number-guessing-game/internal/service"
+	"github.com/go-number-guessing-game/internal/setup"
 	"github.com/go-number-guessing-game/internal/store"
+	"github.com/go-number-guessing-game/internal/timer"
+	"github.com/go-number-guessing-game/internal/tournament"
+	"github.com/go-number-guessing-game/internal/tui"
 )
 
-// The main function serves as the entry point for the app.
+// The main function serves as the entry point for the app, exiting with
+// the status of the run.
 func main() {
-	// Load game configuration from a YAML file.
+	os.Exit(run())
+}
+
+// run plays the game or runs the command given on the command line and
+// returns the exit status, so that deferred calls such as closing the
+// transcript run before the app exits.
+func run() int {
+	// Parse the command-line flags selecting the user interface, theme and
+	// stdio protocol.
+	ui := flag.String("ui", "line", `user interface: "line" or "tui"`)
+	themeName := flag.String("theme", "default", "color theme from configs/themes.yaml")
+	accessible := flag.Bool("accessible", false, "plain output for screen readers")
+	protocolName := flag.String("protocol", "text", `stdio protocol: "text" or "jsonl"`)
+	recordPath := flag.String("record", "", "asciicast v2 file to record the session to")
+	guessTime := flag.Int("guess-time", 0, "time-attack seconds to enter each guess")
+	gameTime := flag.Int("game-time", 0, "time-attack seconds to win the round")
+	ranking := flag.String("rank", "level", `leaderboard order: "level" or "points"`)
+	variant := flag.String("variant", "number", `game variant: "number", "code", "multi" or "drift"`)
+	digits := flag.Int("digits", game.DefaultCodeDigits, "digits of the codes of the code variant, from 1 to 9")
+	secrets := flag.Int("secrets", game.DefaultSecrets, "hidden numbers of the multi variant, from 2 to 5")
+	seed := flag.Uint64("seed", 0, "seed of the moves of the drift variant, random when 0")
+	liar := flag.Bool("liar", false, "lying-oracle mode with the lies of configs/lies.yaml")
+	flag.Parse()
+
+	// Tee the standard output and input into an asciicast transcript when
+	// recording, echoing the input as a terminal does.
+	var stdout io.Writer = os.Stdout
+	var stdin io.Reader = os.Stdin
+	if *recordPath != "" {
+		file, err := os.Create(*recordPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer file.Close()
+
+		recorder, err := asciicast.NewRecorder(file, &timer.DefaultTimer{},
+			asciicast.Header{
+				Title: "Number Guessing Game",
+				Env:   map[string]string{"TERM": os.Getenv("TERM")},
+			},
+			cli.IsTerminal(os.Stdin),
+		)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+
+		stdout = io.MultiWriter(os.Stdout, recorder.Output())
+		stdin = io.TeeReader(os.Stdin, recorder.Input())
+	}
+
+	// Load game configuration from a YAML file, with the one-line prompts
+	// and no spacer lines in accessible mode.
 	gameConfig := config.LoadConfig("yaml", "configs/app.yaml")
+	if *accessible {
+		gameConfig = config.MergeConfig(gameConfig,
+			config.LoadConfig("yaml", "configs/accessible.yaml"),
+		)
+	}
 
 	// Generate a new random number for the game.
 	randomNumber := game.NewRandomNumber()
 
-	// Initialize the scores store with the specified file path.
-	gameStore := &store.ScoresStore{FilePath: "internal/data/scores.json"}
+	// Initialize the scores store with the specified file path and ranking.
+	leaderboardRanking, err := store.ParseRanking(*ranking)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	gameStore := &store.ScoresStore{
+		FilePath: "internal/data/scores.json",
+		Ranking:  leaderboardRanking,
+	}
+
+	// Score won rounds with the points formula from the scoring config.
+	formula, err := scoring.NewFormula(
+		config.LoadConfig("yaml", "configs/scoring.yaml"),
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	// Start a new season of the leaderboard after the season length from the
+	// seasons config.
+	seasonLength, err := season.NewLength(
+		config.LoadConfig("yaml", "configs/seasons.yaml"),
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	// Validate player names within the display widths of the names config.
+	nameLimits, err := parser.NewNameLimits(
+		config.LoadConfig("yaml", "configs/names.yaml"),
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	// Give hints after wrong guesses with the strategy of each level from the
+	// hints config.
+	hints, err := hint.NewStrategies(
+		config.LoadConfig("yaml", "configs/hints.yaml"),
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	// In lying-oracle mode, tell the lies allowed per level by the lies
+	// config.
+	var oracleLiar oracle.Liar
+	if *liar {
+		oracleLiar, err = oracle.NewLiar(
+			config.LoadConfig("yaml", "configs/lies.yaml"),
+		)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+
+	// Crack digit codes told their bulls and cows in the code variant, or
+	// find several hidden numbers in the multi variant, or chase a moving
+	// number in the drift variant.
+	gameVariant, err := game.ParseVariant(*variant)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if *secrets < game.MinSecrets || *secrets > game.MaxSecrets {
+		fmt.Fprintln(os.Stderr, game.NewSecretsError())
+		return 1
+	}
+	if *digits < game.MinCodeDigits || *digits > game.MaxCodeDigits {
+		fmt.Fprintln(os.Stderr, game.NewCodeDigitsError())
+		return 1
+	}
 
 	// Create a CLI input source to read user input from standard input.
-	cliInputSource := &cli.CliInput{Source: os.Stdin}
+	cliInputSource := &cli.CliInput{Source: stdin}
 
-	// Set up the game with the writer, input source, and configuration.
+	// Set up the game with the writer, input source, and configuration,
+	// saving the round when the player quits mid-game, recording every
+	// finished round, rating the player after it and awarding badges, and
+	// keeping the seasons of the leaderboard.
 	game := service.Game{
-		Writer:      os.Stdout,
-		InputSource: cliInputSource,
-		GameConfig:  gameConfig,
+		Writer:           stdout,
+		InputSource:      cliInputSource,
+		GameConfig:       gameConfig,
+		Accessible:       *accessible,
+		SaveStore:        &save.FileStore{FilePath: "internal/data/save.json"},
+		RecordStore:      &record.FileStore{FilePath: "internal/data/games.json"},
+		RatingStore:      &rating.FileStore{FilePath: "internal/data/ratings.json"},
+		AchievementStore: &achievement.FileStore{FilePath: "internal/data/badges.json"},
+		TournamentStore:  &tournament.FileStore{FilePath: "internal/data/tournament.json"},
+		SeasonStore:      &season.FileStore{FilePath: "internal/data/seasons.json"},
+		SeasonLength:     seasonLength,
+		GuessTime:        time.Duration(*guessTime) * time.Second,
+		GameTime:         time.Duration(*gameTime) * time.Second,
+		Formula:          formula,
+		Rounds: setup.Builder{
+			Variant:    gameVariant,
+			CodeDigits: *digits,
+			Secrets:    *secrets,
+			Seed:       *seed,
+			Hints:      hints,
+			Liar:       oracleLiar,
+		},
+		Ranking:    leaderboardRanking,
+		NameLimits: nameLimits,
+		Commit:     true,
+		Terminal:   cli.IsTerminal(os.Stdout),
+	}
+
+	// Exchange JSON lines with bots instead of English prompts, sharing the
+	// same game flow.
+	if *protocolName == "jsonl" {
+		game.Writer = io.Discard
+		output := &protocol.Output{Writer: stdout}
+		game.InputSource = &protocol.Input{Source: stdin}
+		game.Reporter = output
+		game.Subscribers = append(game.Subscribers, output)
+		game.RollSeason(gameStore, false)
+		game.PlayGame(randomNumber, gameStore)
+		return 0
 	}
 
-	// Start the game with the generated random number and the scores store.
+	// Pick the profile of the player at startup, playing its preferred
+	// level, with the messages of its preferred locale and, unless -theme is
+	// given, its preferred theme.
+	game.ProfileStore = &profile.FileStore{FilePath: "internal/data/profiles.json"}
+	game.Locales = loadLocales(*accessible)
+
+	// Style the output only on a terminal without NO_COLOR set, and never
+	// in accessible mode.
+	if cli.ColorEnabled(os.Stdout) && !*accessible {
+		themes := config.LoadConfig("yaml", "configs/themes.yaml")
+		game.Theme = cli.NewTheme(themes, *themeName)
+		if !isFlagSet("theme") {
+			game.Themes = themes
+		}
+	}
+
+	// Use the full-screen view only on a terminal, keeping plain lines for
+	// pipes and screen readers. The prompts go through the screen so that
+	// they don't interleave with its running clock.
+	if *ui == "tui" && cli.IsTerminal(os.Stdout) && !*accessible {
+		game.Screen = &tui.Screen{
+			Writer:     stdout,
+			GameConfig: gameConfig,
+			Width:      tui.DefaultWidth,
+			Refresh:    time.Second,
+		}
+		game.Writer = game.Screen
+	}
+
+	// Scope the leaderboard to the current season, starting a new one once
+	// the season is over, or right away with the "season new" command.
+	game.RollSeason(gameStore, flag.Arg(0) == "season" && flag.Arg(1) == "new")
+
+	// Show the current season and the champions of the past ones with the
+	// season command.
+	if flag.Arg(0) == "season" {
+		if flag.Arg(1) != "new" {
+			game.ShowSeasons()
+		}
+		return 0
+	}
+
+	// Show the leaderboard of the current season, or of a past one with
+	// "scores -season N", with the scores command.
+	if flag.Arg(0) == "scores" {
+		flags := flag.NewFlagSet("scores", flag.ContinueOnError)
+		number := flags.Int("season", 0, "season of the leaderboard, the current one when 0")
+		if err := flags.Parse(flag.Args()[1:]); err != nil {
+			return usageStatus(err)
+		}
+		game.ShowScores(gameStore, *number)
+		return 0
+	}
+
+	// List the profiles, or set the preferred level and theme of a profile,
+	// with the profile command.
+	if flag.Arg(0) == "profile" {
+		return setProfile(&game, flag.Args()[1:])
+	}
+
+	// Show the ratings leaderboard and a player's rating history with the
+	// stats command.
+	if flag.Arg(0) == "stats" {
+		game.ShowStats(flag.Arg(1))
+		return 0
+	}
+
+	// List the badges of every player, and every badge of a player, with the
+	// badges command.
+	if flag.Arg(0) == "badges" {
+		game.ShowBadges(flag.Arg(1))
+		return 0
+	}
+
+	// Simulate the lying-oracle solver for up to the given number of lies,
+	// 3 by default, with the simulate command.
+	if flag.Arg(0) == "simulate" {
+		maxLies, err := strconv.Atoi(flag.Arg(1))
+		if err != nil {
+			maxLies = 3
+		}
+		game.ShowSimulation(maxLies)
+		return 0
+	}
+
+	// Register a tournament, play its matches or show its standings with
+	// the tournament command.
+	if flag.Arg(0) == "tournament" {
+		return playTournament(&game, gameStore, flag.Args()[1:])
+	}
+
+	// Replay a recorded game with the replay command, in real time by
+	// default.
+	if flag.Arg(0) == "replay" {
+		return replayGame(&game, gameConfig, stdin, flag.Args()[1:])
+	}
+
+	// Verify that a round kept the secret it committed to with the verify
+	// command, given a recorded game or a revealed commitment.
+	if flag.Arg(0) == "verify" {
+		return verifyGame(&game, flag.Args()[1:])
+	}
+
+	// Resume the saved round with the resume command, or start the game with
+	// the generated random number and the scores store.
+	if flag.Arg(0) == "resume" {
+		game.ResumeGame(gameStore)
+		return 0
+	}
 	game.PlayGame(randomNumber, gameStore)
+	return 0
+}
+
+// playTournament parses the arguments of the tournament command, such as
+// "tournament new -format elimination -level 2 ann bob cid", "tournament
+// play" or "tournament show", runs it and returns the exit status.
+func playTournament(game *service.Game, gameStore store.Store, args []string) int {
+	if len(args) == 0 {
+		args = []string{"show"}
+	}
+
+	switch args[0] {
+	case "new":
+		flags := flag.NewFlagSet("tournament new", flag.ContinueOnError)
+		format := flags.String("format", tournament.RoundRobin,
+			`bracket: "round_robin" or "elimination"`,
+		)
+		level := flags.String("level", "1", "difficulty choice, from 1 to 3")
+		if err := flags.Parse(args[1:]); err != nil {
+			return usageStatus(err)
+		}
+		game.NewTournament(*format, *level, flags.Args())
+
+	case "play":
+		game.PlayTournament(gameStore)
+
+	case "show":
+		game.ShowTournament()
+
+	default:
+		fmt.Fprintln(os.Stderr,
+			"usage: tournament [new [-format F] [-level N] <players> | play | show]",
+		)
+		return 2
+	}
+	return 0
+}
+
+// setProfile parses the arguments of the profile command, such as "profile"
+// or "profile set -level 2 -locale fr -theme mono alice", runs it and
+// returns the exit status. The locale must have a message config in
+// configs/locales, and the theme be defined in the themes config.
+func setProfile(game *service.Game, args []string) int {
+	if len(args) == 0 {
+		game.ShowProfiles()
+		return 0
+	}
+
+	flags := flag.NewFlagSet("profile set", flag.ContinueOnError)
+	level := flags.String("level", "", "preferred difficulty choice, from 1 to 4")
+	locale := flags.String("locale", "", "preferred locale from configs/locales, such as fr")
+	theme := flags.String("theme", "", "preferred theme from configs/themes.yaml")
+	if args[0] != "set" || flags.Parse(args[1:]) != nil || flags.NArg() == 0 {
+		fmt.Fprintln(os.Stderr,
+			"usage: profile [set [-level N] [-locale NAME] [-theme NAME] <name>]",
+		)
+		return 2
+	}
+
+	game.Themes = config.LoadConfig("yaml", "configs/themes.yaml")
+	game.SetProfile(strings.Join(flags.Args(), " "), *level, *locale, *theme)
+	return 0
+}
+
+// loadLocales loads the message configs of the locales, with their one-line
+// prompts from configs/locales/accessible in accessible mode.
+func loadLocales(accessible bool) map[string]map[string]string {
+	locales := config.LoadLocales("yaml", "configs/locales")
+	if accessible {
+		overrides := config.LoadLocales("yaml", "configs/locales/accessible")
+		for locale, messages := range locales {
+			locales[locale] = config.MergeConfig(messages, overrides[locale])
+		}
+	}
+	return locales
+}
+
+// usageStatus returns the exit status after a command failed to parse its
+// flags: 0 when only help was asked for, and 2 for a usage error.
+func usageStatus(err error) int {
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	return 2
+}
+
+// isFlagSet reports whether the command-line flag was given.
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		set = set || f.Name == name
+	})
+	return set
+}
+
+// verifyGame parses the arguments of the verify command, such as "verify 3"
+// for the recorded game 3 or "verify <hash> <secret> <nonce>" for the
+// commitment revealed at the end of a round, verifies it and returns the
+// exit status.
+func verifyGame(game *service.Game, args []string) int {
+	switch len(args) {
+	case 1:
+		if id, err := strconv.Atoi(args[0]); err == nil {
+			game.VerifyGame(id)
+			return 0
+		}
+	case 3:
+		game.VerifyCommitment(args[0], args[1], args[2])
+		return 0
+	}
+
+	fmt.Fprintln(os.Stderr, "usage: verify <id> | verify <hash> <secret> <nonce>")
+	return 2
+}
+
+// replayGame parses the arguments of the replay command, such as
+// "replay -speed 4 3" or "replay -step 3", replays the game and returns the
+// exit status.
+func replayGame(
+	game *service.Game,
+	gameConfig map[string]string,
+	stdin io.Reader,
+	args []string,
+) int {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	speed := flags.Float64("speed", 1, "replay speed, 1 being real time")
+	step := flags.Bool("step", false, "wait for Enter before each step")
+	if err := flags.Parse(args); err != nil {
+		return usageStatus(err)
+	}
+
+	id, err := strconv.Atoi(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "usage: replay [-speed N | -step] <id>")
+		return 2
+	}
+
+	var pacer replay.Pacer = &replay.Clock{Speed: *speed}
+	if *step {
+		pacer = &replay.Stepper{
+			Source: stdin,
+			Writer: game.Writer,
+			Prompt: gameConfig["replay_step"],
+		}
+	}
+
+	game.ReplayGame(id, pacer)
+	return 0
 }
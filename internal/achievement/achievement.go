@@ -0,0 +1,328 @@
+// Package achievement awards badges to players from the events of their
+// rounds, and keeps the badges and streaks of every player in a JSON file.
+package achievement
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/timer"
+	"github.com/olekukonko/tablewriter"
+)
+
+// NoBadges is the message displayed when no player has earned a badge yet.
+// It is public for testing purposes.
+const NoBadges = "No badges yet. Please win a game to earn one."
+
+// The IDs of the badges.
+const (
+	FirstWin     = "first_win"
+	FirstGuess   = "first_guess"
+	CloseCall    = "close_call"
+	WinStreak    = "win_streak"
+	BinarySearch = "binary_search"
+	DailyStreak  = "daily_streak"
+)
+
+// StreakLength is the number of rounds won in a row for the win streak
+// badge, and DailyStreakLength the number of days in a row with a win for
+// the daily streak badge.
+const (
+	StreakLength      = 10
+	DailyStreakLength = 7
+)
+
+// Badge is an achievement a player can earn once.
+type Badge struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+// Badges lists every badge, in the order they are displayed.
+var Badges = []Badge{
+	{ID: FirstWin, Name: "First Win", Description: "Win a round."},
+	{ID: FirstGuess, Name: "Bullseye", Description: "Win on the first guess."},
+	{ID: CloseCall, Name: "Close Call", Description: "Win Hard with one attempt left."},
+	{ID: WinStreak, Name: "On Fire", Description: "Win 10 rounds in a row."},
+	{ID: BinarySearch, Name: "Binary Search", Description: "Win guessing the middle of the possible range every time."},
+	{ID: DailyStreak, Name: "Daily Player", Description: "Win a round 7 days in a row."},
+}
+
+// Find returns the badge with the ID, and whether it exists.
+func Find(id string) (Badge, bool) {
+	for _, badge := range Badges {
+		if badge.ID == id {
+			return badge, true
+		}
+	}
+	return Badge{}, false
+}
+
+// Earned is a badge earned by a player, with the date it was earned.
+type Earned struct {
+	ID   string    `json:"id"`
+	Date time.Time `json:"date"`
+}
+
+// Progress holds the badges earned by a player, and the streaks counting
+// towards the streak badges: Streak counts the rounds won in a row, and
+// DailyStreak the days in a row with a win, the last one being LastDay.
+type Progress struct {
+	Badges      []Earned `json:"badges"`
+	Streak      int      `json:"streak"`
+	DailyStreak int      `json:"daily_streak"`
+	LastDay     string   `json:"last_day,omitempty"`
+}
+
+// Has reports whether the badge with the ID was earned.
+func (p Progress) Has(id string) bool {
+	for _, earned := range p.Badges {
+		if earned.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats every badge as an ASCII table, with the date it was earned
+// or as locked.
+func (p Progress) String() string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Badge", "Description", "Earned"})
+
+	for _, badge := range Badges {
+		earned := "Locked"
+		for _, e := range p.Badges {
+			if e.ID == badge.ID {
+				earned = e.Date.Format(time.DateOnly)
+			}
+		}
+
+		table.Append([]string{badge.Name, badge.Description, earned})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Players holds the progress of every player, by name.
+type Players map[string]Progress
+
+// String formats the badges earned by every player as an ASCII table,
+// sorted by number of badges, then by name.
+func (p Players) String() string {
+	names := make([]string, 0, len(p))
+	for name, progress := range p {
+		if len(progress.Badges) > 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return NoBadges
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if len(p[names[i]].Badges) != len(p[names[j]].Badges) {
+			return len(p[names[i]].Badges) > len(p[names[j]].Badges)
+		}
+		return names[i] < names[j]
+	})
+
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Player", "Count", "Badges"})
+
+	for _, name := range names {
+		badges := make([]string, len(p[name].Badges))
+		for i, earned := range p[name].Badges {
+			badge, _ := Find(earned.ID)
+			badges[i] = badge.Name
+		}
+
+		table.Append([]string{
+			name,
+			strconv.Itoa(len(badges)),
+			strings.Join(badges, ", "),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Store defines methods for loading the progress of the players and saving
+// the progress of one, facilitating testing.
+type Store interface {
+	Load() Players
+	Save(player string, progress Progress) (Players, error)
+}
+
+// Recorder subscribes to the game engine to award badges after every round.
+// Engine is the subscribed engine, read for the turns of the round. Timer
+// dates the badges and the daily streaks, defaulting to the local time.
+// Earned holds the badges earned in the round and Err the error saving them.
+type Recorder struct {
+	Store  Store
+	Engine *engine.Engine
+	Timer  timer.Timer
+	Earned []Badge
+	Err    error
+}
+
+// Notify awards the badges earned when the event ends the round, and
+// updates the streaks of the player.
+func (r *Recorder) Notify(event engine.Event) {
+	switch e := event.(type) {
+	case engine.GameWon:
+		r.update(e.Player, func(progress *Progress, now time.Time) []string {
+			progress.Streak++
+			r.updateDailyStreak(progress, now)
+			return r.evaluate(e, *progress)
+		})
+
+	case engine.GameLost:
+		r.update(e.Player, func(progress *Progress, _ time.Time) []string {
+			progress.Streak = 0
+			return nil
+		})
+	}
+}
+
+func (r *Recorder) update(
+	player string,
+	apply func(progress *Progress, now time.Time) []string,
+) {
+	clock := r.Timer
+	if clock == nil {
+		clock = &timer.DefaultTimer{}
+	}
+	now := clock.Now()
+
+	r.Earned = nil
+	progress := r.Store.Load()[player]
+	for _, id := range apply(&progress, now) {
+		if progress.Has(id) {
+			continue
+		}
+
+		badge, _ := Find(id)
+		progress.Badges = append(progress.Badges, Earned{ID: id, Date: now})
+		r.Earned = append(r.Earned, badge)
+	}
+
+	_, r.Err = r.Store.Save(player, progress)
+}
+
+func (r *Recorder) updateDailyStreak(progress *Progress, now time.Time) {
+	today := now.Format(time.DateOnly)
+	yesterday := now.AddDate(0, 0, -1).Format(time.DateOnly)
+
+	switch progress.LastDay {
+	case today:
+	case yesterday:
+		progress.DailyStreak++
+	default:
+		progress.DailyStreak = 1
+	}
+	progress.LastDay = today
+}
+
+// evaluate returns the IDs of the badges the won round qualifies for.
+func (r *Recorder) evaluate(won engine.GameWon, progress Progress) []string {
+	ids := []string{FirstWin}
+
+	if won.Attempts == 1 {
+		ids = append(ids, FirstGuess)
+	}
+	if won.Level == "Hard" && won.MaxAttempts-won.Attempts == 1 {
+		ids = append(ids, CloseCall)
+	}
+	if progress.Streak >= StreakLength {
+		ids = append(ids, WinStreak)
+	}
+	if r.Engine != nil && PerfectBinarySearch(r.Engine.State()) {
+		ids = append(ids, BinarySearch)
+	}
+	if progress.DailyStreak >= DailyStreakLength {
+		ids = append(ids, DailyStreak)
+	}
+
+	return ids
+}
+
+// PerfectBinarySearch reports whether every guess of the round was the
+// middle of the range still possible before it, either one of the two
+// middles of an even range, and no attempt was spent otherwise. Codes and
+// hidden numbers of the multi variant are never searched in binary.
+func PerfectBinarySearch(gameState game.GameState) bool {
+	if len(gameState.Turns) == 0 ||
+		gameState.Variant != "" ||
+		gameState.HintsUsed > 0 ||
+		gameState.Expired > 0 {
+		return false
+	}
+
+	for i, turn := range gameState.Turns {
+		previous := game.GameState{
+			Turns: gameState.Turns[:i],
+			Min:   gameState.Min,
+			Max:   gameState.Max,
+		}
+		low, high := previous.PossibleRange()
+		if turn.GuessNumber != (low+high)/2 &&
+			turn.GuessNumber != (low+high+1)/2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FileStore manages the file path of the progress of the players, which
+// must be a JSON file.
+type FileStore struct {
+	FilePath string
+}
+
+// Load retrieves the progress of the players. If none exists, it returns
+// empty Players.
+func (s *FileStore) Load() Players {
+	byt, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return Players{}
+	}
+
+	var players Players
+	if err = json.Unmarshal(byt, &players); err != nil || players == nil {
+		return Players{}
+	}
+
+	return players
+}
+
+// Save replaces the progress of the player, returning the progress of every
+// player.
+func (s *FileStore) Save(player string, progress Progress) (Players, error) {
+	players := s.Load()
+	players[player] = progress
+
+	byt, err := json.Marshal(players)
+	if err != nil {
+		return Players{}, err
+	}
+
+	if err = os.WriteFile(s.FilePath, byt, 0o644); err != nil {
+		return Players{}, err
+	}
+
+	return players, nil
+}
@@ -0,0 +1,200 @@
+package achievement_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/achievement"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationRecorder(t *testing.T) {
+	t.Run("award badges once for a won round", func(t *testing.T) {
+		fileStore := newFileStore(t)
+		round := startRound(t, "Hard", 3, 50)
+		recorder := &achievement.Recorder{
+			Store:  fileStore,
+			Engine: round,
+			Timer:  &StubTimer{now: stubDate},
+		}
+		round.Subscribe(recorder)
+
+		assert.NoError(t, round.Handle(engine.Guess{Number: 50}))
+		assert.NoError(t, recorder.Err)
+		assert.Equal(t, []string{
+			achievement.FirstWin,
+			achievement.FirstGuess,
+			achievement.BinarySearch,
+		}, badgeIDs(recorder.Earned))
+
+		round = startRound(t, "Hard", 3, 50)
+		recorder.Engine = round
+		round.Subscribe(recorder)
+
+		assert.NoError(t, round.Handle(engine.Guess{Number: 10}))
+		assert.NoError(t, round.Handle(engine.Guess{Number: 50}))
+		assert.Equal(t, []string{achievement.CloseCall}, badgeIDs(recorder.Earned))
+
+		progress := fileStore.Load()["test"]
+		assert.Len(t, progress.Badges, 4)
+		assert.Equal(t, stubDate, progress.Badges[0].Date)
+		assert.Equal(t, 2, progress.Streak)
+	})
+
+	t.Run("award the win streak and reset it when lost", func(t *testing.T) {
+		fileStore := newFileStore(t)
+		recorder := &achievement.Recorder{
+			Store: fileStore,
+			Timer: &StubTimer{now: stubDate},
+		}
+		won := engine.GameWon{Player: "test", Level: "Easy", MaxAttempts: 10, Attempts: 5}
+
+		for range achievement.StreakLength - 1 {
+			recorder.Notify(won)
+		}
+		recorder.Notify(engine.GameLost{Player: "test", Level: "Easy"})
+		assert.Equal(t, 0, fileStore.Load()["test"].Streak)
+
+		for range achievement.StreakLength {
+			recorder.Notify(won)
+		}
+		assert.Equal(t, []string{achievement.WinStreak}, badgeIDs(recorder.Earned))
+	})
+
+	t.Run("award the daily streak for wins on consecutive days", func(t *testing.T) {
+		fileStore := newFileStore(t)
+		clock := &StubTimer{now: stubDate}
+		recorder := &achievement.Recorder{Store: fileStore, Timer: clock}
+		won := engine.GameWon{Player: "test", Level: "Easy", MaxAttempts: 10, Attempts: 5}
+
+		recorder.Notify(won)
+		clock.now = clock.now.AddDate(0, 0, 2)
+		for range achievement.DailyStreakLength - 1 {
+			recorder.Notify(won)
+			recorder.Notify(won)
+			clock.now = clock.now.AddDate(0, 0, 1)
+		}
+		assert.Equal(t, achievement.DailyStreakLength-1, fileStore.Load()["test"].DailyStreak)
+		assert.False(t, fileStore.Load()["test"].Has(achievement.DailyStreak))
+
+		recorder.Notify(won)
+		assert.Equal(t, []string{achievement.DailyStreak}, badgeIDs(recorder.Earned))
+	})
+}
+
+func TestIntegrationPerfectBinarySearch(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			guesses     []int
+			hintsUsed   int
+			want        bool
+		}{
+			{description: "middle guesses", guesses: []int{50, 75, 63, 69, 72, 70, 71}, want: true},
+			{description: "upper middle of an even range", guesses: []int{51, 76}, want: true},
+			{description: "guess off the middle", guesses: []int{50, 80}, want: false},
+			{description: "hint spent", guesses: []int{50}, hintsUsed: 1, want: false},
+			{description: "no guesses", guesses: []int{}, want: false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: 71,
+					Turns:        game.Turns{},
+				}
+				for _, guess := range tc.guesses {
+					assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+				}
+				gameState.HintsUsed = tc.hintsUsed
+
+				got := achievement.PerfectBinarySearch(gameState)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
+
+func TestIntegrationPlayersString(t *testing.T) {
+	t.Run("format the badges of every player", func(t *testing.T) {
+		players := achievement.Players{
+			"bob": {Badges: []achievement.Earned{{ID: achievement.FirstWin}}},
+			"alice": {Badges: []achievement.Earned{
+				{ID: achievement.FirstWin},
+				{ID: achievement.FirstGuess},
+			}},
+		}
+
+		want := "" +
+			"+--------+-------+---------------------+\n" +
+			"| PLAYER | COUNT |       BADGES        |\n" +
+			"+--------+-------+---------------------+\n" +
+			"| alice  |     2 | First Win, Bullseye |\n" +
+			"| bob    |     1 | First Win           |\n" +
+			"+--------+-------+---------------------+\n"
+		assert.Equal(t, want, players.String())
+	})
+
+	t.Run("return no badges", func(t *testing.T) {
+		assert.Equal(t, achievement.NoBadges, achievement.Players{}.String())
+	})
+
+	t.Run("list locked and earned badges of a player", func(t *testing.T) {
+		progress := achievement.Progress{
+			Badges: []achievement.Earned{{ID: achievement.FirstWin, Date: stubDate}},
+		}
+
+		got := progress.String()
+		assert.Contains(t, got, "2001-01-01")
+		assert.Contains(t, got, "Locked")
+	})
+}
+
+func newFileStore(t *testing.T) *achievement.FileStore {
+	t.Helper()
+
+	return &achievement.FileStore{
+		FilePath: filepath.Join(t.TempDir(), "badges.json"),
+	}
+}
+
+func startRound(
+	t *testing.T,
+	level string,
+	maxAttempts, randomNumber int,
+) *engine.Engine {
+	t.Helper()
+
+	round := &engine.Engine{Timer: &StubTimer{now: stubDate}}
+	assert.NoError(t, round.Handle(engine.Start{
+		Player:       "test",
+		Level:        level,
+		MaxAttempts:  maxAttempts,
+		RandomNumber: randomNumber,
+	}))
+
+	return round
+}
+
+func badgeIDs(badges []achievement.Badge) []string {
+	ids := []string{}
+	for _, badge := range badges {
+		ids = append(ids, badge.ID)
+	}
+	return ids
+}
+
+var stubDate = time.Date(2001, 1, 1, 1, 1, 0, 0, time.UTC)
+
+type StubTimer struct {
+	now time.Time
+}
+
+func (s *StubTimer) Now() time.Time {
+	return s.now
+}
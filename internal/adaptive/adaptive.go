@@ -0,0 +1,159 @@
+// Package adaptive tunes the maximum attempts and the range of the adaptive
+// level between rounds, from the recent results of the player: consistently
+// winning with spare attempts tightens the round, and losing or winning on
+// the last attempt loosens it.
+package adaptive
+
+import (
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/go-number-guessing-game/internal/store"
+)
+
+// Parameters are the maximum attempts and the range of an adaptive round.
+type Parameters struct {
+	MaxAttempts int
+	Min         int
+	Max         int
+}
+
+// Result is a finished adaptive round of a player.
+type Result struct {
+	Won         bool
+	Attempts    int
+	MaxAttempts int
+	Min         int
+	Max         int
+}
+
+// ScoreResults returns the adaptive rounds of the player won with the
+// scores, oldest first. Lost rounds leave no score.
+func ScoreResults(scores store.Scores, player string) []Result {
+	var results []Result
+	for _, score := range scores {
+		if score.Player != player ||
+			score.Level != game.AdaptiveLevel ||
+			score.Variant != "" ||
+			score.MaxAttempts <= 0 {
+			continue
+		}
+		results = append(results, Result{
+			Won:         true,
+			Attempts:    score.Attempts,
+			MaxAttempts: score.MaxAttempts,
+			Min:         score.Min,
+			Max:         score.Max,
+		})
+	}
+
+	return results
+}
+
+// GameResults returns the recorded adaptive rounds of the player, won or
+// lost, oldest first.
+func GameResults(games record.Games, player string) []Result {
+	var results []Result
+	for _, recorded := range games {
+		if recorded.Player != player ||
+			recorded.Level != game.AdaptiveLevel ||
+			recorded.Variant != "" ||
+			recorded.MaxAttempts <= 0 {
+			continue
+		}
+		results = append(results, Result{
+			Won:         recorded.Won,
+			Attempts:    recorded.Attempts(),
+			MaxAttempts: recorded.MaxAttempts,
+			Min:         recorded.Min,
+			Max:         recorded.Max,
+		})
+	}
+
+	return results
+}
+
+// Tuner derives the parameters of the next round from the last Window
+// adaptive results of the player. Rounds all won with at least Spare
+// attempts left tighten the next one, first by removing an attempt down to
+// what a binary search needs, then by doubling the range up to MaxNumber. A
+// round lost or won on its last attempt loosens the next one the other way
+// round, back to the range of Initial and then adding attempts up to
+// MaxAttempts.
+type Tuner struct {
+	Initial     Parameters
+	Window      int
+	Spare       int
+	MaxAttempts int
+	MaxNumber   int
+}
+
+// DefaultTuner starts players with the attempts of the Easy level over the
+// usual range, tightening after three rounds won with two attempts to spare.
+var DefaultTuner = Tuner{
+	Initial: Parameters{
+		MaxAttempts: 10,
+		Min:         game.MinNumber,
+		Max:         game.MaxNumber,
+	},
+	Window:      3,
+	Spare:       2,
+	MaxAttempts: 15,
+	MaxNumber:   1000,
+}
+
+// Tune returns the parameters of the next adaptive round from the results
+// of the previous rounds of the player, oldest first.
+func (t Tuner) Tune(results []Result) Parameters {
+	if len(results) == 0 {
+		return t.Initial
+	}
+	recent := results[max(len(results)-t.Window, 0):]
+
+	last := recent[len(recent)-1]
+	parameters := Parameters{
+		MaxAttempts: last.MaxAttempts,
+		Min:         last.Min,
+		Max:         last.Max,
+	}
+	if parameters.Min == 0 && parameters.Max == 0 {
+		parameters.Min, parameters.Max = t.Initial.Min, t.Initial.Max
+	}
+
+	switch {
+	case !last.Won || last.Attempts >= last.MaxAttempts:
+		return t.loosen(parameters)
+	case len(recent) == t.Window && t.spared(recent):
+		return t.tighten(parameters)
+	default:
+		return parameters
+	}
+}
+
+func (t Tuner) spared(results []Result) bool {
+	for _, result := range results {
+		if !result.Won || result.MaxAttempts-result.Attempts < t.Spare {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Tuner) tighten(p Parameters) Parameters {
+	if p.MaxAttempts > game.OptimalGuesses(p.Min, p.Max) {
+		p.MaxAttempts--
+		return p
+	}
+
+	p.Max = min(p.Min+2*(p.Max-p.Min+1)-1, t.MaxNumber)
+	return p
+}
+
+func (t Tuner) loosen(p Parameters) Parameters {
+	if p.Max > t.Initial.Max {
+		p.Max = max(p.Min+(p.Max-p.Min+1)/2-1, t.Initial.Max)
+		return p
+	}
+
+	p.MaxAttempts = min(p.MaxAttempts+1, t.MaxAttempts)
+	return p
+}
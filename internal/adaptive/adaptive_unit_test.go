@@ -0,0 +1,189 @@
+package adaptive_test
+
+import (
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/adaptive"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/go-number-guessing-game/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTunerTune(t *testing.T) {
+	tuner := adaptive.DefaultTuner
+
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			scores      store.Scores
+			want        adaptive.Parameters
+		}{
+			{
+				description: "initial parameters without scores",
+				scores:      store.Scores{},
+				want:        adaptive.Parameters{MaxAttempts: 10, Min: 1, Max: 100},
+			},
+			{
+				description: "initial parameters without adaptive scores of the player",
+				scores: store.Scores{
+					{Player: "other", Level: "Adaptive", Attempts: 2, MaxAttempts: 7, Min: 1, Max: 100},
+					{Player: "test", Level: "Hard", Attempts: 2, MaxAttempts: 3},
+					{Player: "test", Level: "Adaptive", Attempts: 2},
+				},
+				want: adaptive.Parameters{MaxAttempts: 10, Min: 1, Max: 100},
+			},
+			{
+				description: "same parameters before enough rounds",
+				scores: store.Scores{
+					adaptiveScore(2, 8, 100),
+					adaptiveScore(3, 8, 100),
+				},
+				want: adaptive.Parameters{MaxAttempts: 8, Min: 1, Max: 100},
+			},
+			{
+				description: "same parameters without enough spare attempts",
+				scores: store.Scores{
+					adaptiveScore(2, 8, 100),
+					adaptiveScore(7, 8, 100),
+					adaptiveScore(3, 8, 100),
+				},
+				want: adaptive.Parameters{MaxAttempts: 8, Min: 1, Max: 100},
+			},
+			{
+				description: "one attempt less after consistent wins",
+				scores: store.Scores{
+					adaptiveScore(2, 8, 100),
+					adaptiveScore(6, 8, 100),
+					adaptiveScore(3, 8, 100),
+				},
+				want: adaptive.Parameters{MaxAttempts: 7, Min: 1, Max: 100},
+			},
+			{
+				description: "double range once attempts are optimal",
+				scores: store.Scores{
+					adaptiveScore(2, 7, 100),
+					adaptiveScore(5, 7, 100),
+					adaptiveScore(3, 7, 100),
+				},
+				want: adaptive.Parameters{MaxAttempts: 7, Min: 1, Max: 200},
+			},
+			{
+				description: "range limited to the max number",
+				scores: store.Scores{
+					adaptiveScore(2, 7, 1000),
+					adaptiveScore(5, 7, 1000),
+					adaptiveScore(3, 7, 1000),
+				},
+				want: adaptive.Parameters{MaxAttempts: 7, Min: 1, Max: 1000},
+			},
+			{
+				description: "halve range after a win on the last attempt",
+				scores: store.Scores{
+					adaptiveScore(2, 7, 400),
+					adaptiveScore(7, 7, 400),
+				},
+				want: adaptive.Parameters{MaxAttempts: 7, Min: 1, Max: 200},
+			},
+			{
+				description: "one attempt more after a win on the last attempt",
+				scores: store.Scores{
+					adaptiveScore(10, 10, 100),
+				},
+				want: adaptive.Parameters{MaxAttempts: 11, Min: 1, Max: 100},
+			},
+			{
+				description: "attempts limited to the max attempts",
+				scores: store.Scores{
+					adaptiveScore(15, 15, 100),
+				},
+				want: adaptive.Parameters{MaxAttempts: 15, Min: 1, Max: 100},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got := tuner.Tune(adaptive.ScoreResults(tc.scores, "test"))
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
+
+func TestUnitTunerTuneLosses(t *testing.T) {
+	tuner := adaptive.DefaultTuner
+
+	t.Run("widen the attempts after every lost round", func(t *testing.T) {
+		parameters := adaptive.Parameters{MaxAttempts: 7, Min: 1, Max: 200}
+		var results []adaptive.Result
+		for _, want := range []adaptive.Parameters{
+			{MaxAttempts: 7, Min: 1, Max: 100},
+			{MaxAttempts: 8, Min: 1, Max: 100},
+			{MaxAttempts: 9, Min: 1, Max: 100},
+		} {
+			results = append(results, adaptive.Result{
+				Attempts:    parameters.MaxAttempts,
+				MaxAttempts: parameters.MaxAttempts,
+				Min:         parameters.Min,
+				Max:         parameters.Max,
+			})
+
+			parameters = tuner.Tune(results)
+			assert.Equal(t, want, parameters)
+		}
+	})
+
+	t.Run("keep the parameters when a loss is in the window", func(t *testing.T) {
+		lost := adaptive.Result{Attempts: 8, MaxAttempts: 8, Min: 1, Max: 100}
+		won := adaptive.Result{Won: true, Attempts: 2, MaxAttempts: 8, Min: 1, Max: 100}
+
+		got := tuner.Tune([]adaptive.Result{lost, won, won})
+
+		assert.Equal(t, adaptive.Parameters{MaxAttempts: 8, Min: 1, Max: 100}, got)
+	})
+}
+
+func TestUnitGameResults(t *testing.T) {
+	t.Run("return the recorded adaptive rounds of the player", func(t *testing.T) {
+		games := record.Games{
+			{
+				Player:      "test",
+				Level:       "Adaptive",
+				MaxAttempts: 8,
+				Min:         1,
+				Max:         200,
+				Steps: []record.Step{
+					{Action: record.ActionGuess, Number: 100},
+					{Action: record.ActionHint},
+					{Action: record.ActionExpire},
+					{Action: record.ActionGiveUp},
+				},
+			},
+			{Player: "other", Level: "Adaptive", MaxAttempts: 8, Won: true},
+			{Player: "test", Level: "Hard", MaxAttempts: 3, Won: true},
+			{
+				Player:      "test",
+				Level:       "Adaptive",
+				MaxAttempts: 7,
+				Won:         true,
+				Steps:       []record.Step{{Action: record.ActionGuess, Number: 50}},
+			},
+		}
+
+		want := []adaptive.Result{
+			{Attempts: 2, MaxAttempts: 8, Min: 1, Max: 200},
+			{Won: true, Attempts: 1, MaxAttempts: 7},
+		}
+		assert.Equal(t, want, adaptive.GameResults(games, "test"))
+	})
+}
+
+func adaptiveScore(attempts, maxAttempts, max int) store.Score {
+	return store.Score{
+		Player:      "test",
+		Level:       "Adaptive",
+		Attempts:    attempts,
+		MaxAttempts: maxAttempts,
+		Min:         1,
+		Max:         max,
+	}
+}
@@ -0,0 +1,116 @@
+// Package analysis judges the guesses of a finished round against the
+// feedback received before each of them, and compares the round with the
+// guesses a binary search would have needed.
+package analysis
+
+import (
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/oracle"
+)
+
+// Guess is a flagged guess of the round: Turn is its position, from 1, and
+// Low and High the interval still possible before it. Outside is set when
+// the guess couldn't be the number given that interval, and Repeated when
+// it was already played, which is never flagged in the drift variant since
+// the number may have moved back to it.
+type Guess struct {
+	Turn     int
+	Number   int
+	Low      int
+	High     int
+	Outside  bool
+	Repeated bool
+}
+
+// Report is the analysis of a round. Optimal is the number of guesses a
+// binary search would have needed to find the number, or the lying-oracle
+// solver against an oracle lying as soon as allowed in rounds allowing
+// lies, and zero for codes, which aren't searched in binary. Efficiency is
+// the optimal guesses over the guesses played as a percentage, capped at 100
+// for lucky rounds and zero when the round was lost or the number a code.
+type Report struct {
+	Flagged    []Guess
+	Played     int
+	Optimal    int
+	Efficiency int
+}
+
+// Analyze returns the report of the round.
+func Analyze(gameState game.GameState) Report {
+	report := Report{
+		Flagged: []Guess{},
+		Played:  len(gameState.Turns),
+	}
+
+	played := map[int]bool{}
+	for i, turn := range gameState.Turns {
+		previous := game.GameState{
+			Turns:   gameState.Turns[:i],
+			Min:     gameState.Min,
+			Max:     gameState.Max,
+			MaxLies: gameState.MaxLies,
+			Variant: gameState.Variant,
+		}
+		low, high := previous.PossibleRange()
+		repeated := played[turn.GuessNumber] &&
+			gameState.Variant != game.DriftVariant
+
+		guess := Guess{
+			Turn:     i + 1,
+			Number:   turn.GuessNumber,
+			Low:      low,
+			High:     high,
+			Outside:  turn.GuessNumber < low || turn.GuessNumber > high,
+			Repeated: repeated,
+		}
+		if guess.Outside || guess.Repeated {
+			report.Flagged = append(report.Flagged, guess)
+		}
+		played[turn.GuessNumber] = true
+	}
+
+	if gameState.Variant != "" {
+		return report
+	}
+
+	low, high := gameState.Bounds()
+	report.Optimal = BinarySearchGuesses(low, high, gameState.RandomNumber)
+	if gameState.MaxLies > 0 {
+		report.Optimal = oracle.Guesses(
+			low,
+			high,
+			gameState.RandomNumber,
+			gameState.MaxLies,
+			oracle.Liar{Rate: 1},
+		)
+	}
+
+	if turn, err := gameState.GetLastTurn(); err == nil &&
+		turn.Outcome != nil && *turn.Outcome == game.Equal {
+		report.Efficiency = min(100, report.Optimal*100/report.Played)
+	}
+
+	return report
+}
+
+// BinarySearchGuesses returns the number of guesses a binary search,
+// guessing the lower middle of the possible interval, needs to find the
+// number between low and high.
+func BinarySearchGuesses(low, high, number int) int {
+	var guesses int
+	for low <= high {
+		guesses++
+
+		middle := (low + high) / 2
+		switch {
+		case middle == number:
+			return guesses
+		case middle < number:
+			low = middle + 1
+		default:
+			high = middle - 1
+		}
+	}
+
+	return guesses
+}
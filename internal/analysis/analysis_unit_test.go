@@ -0,0 +1,136 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/analysis"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAnalyze(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			guesses     []int
+			want        analysis.Report
+		}{
+			{
+				description: "optimal round",
+				guesses:     []int{50, 75, 62, 68, 71},
+				want: analysis.Report{
+					Flagged:    []analysis.Guess{},
+					Played:     5,
+					Optimal:    5,
+					Efficiency: 100,
+				},
+			},
+			{
+				description: "lucky round",
+				guesses:     []int{71},
+				want: analysis.Report{
+					Flagged:    []analysis.Guess{},
+					Played:     1,
+					Optimal:    5,
+					Efficiency: 100,
+				},
+			},
+			{
+				description: "flagged guesses",
+				guesses:     []int{50, 40, 90, 90, 71},
+				want: analysis.Report{
+					Flagged: []analysis.Guess{
+						{Turn: 2, Number: 40, Low: 51, High: 100, Outside: true},
+						{Turn: 4, Number: 90, Low: 51, High: 89, Outside: true, Repeated: true},
+					},
+					Played:     5,
+					Optimal:    5,
+					Efficiency: 100,
+				},
+			},
+			{
+				description: "slow round",
+				guesses:     []int{10, 20, 30, 40, 50, 60, 70, 80, 75, 71},
+				want: analysis.Report{
+					Flagged:    []analysis.Guess{},
+					Played:     10,
+					Optimal:    5,
+					Efficiency: 50,
+				},
+			},
+			{
+				description: "lost round",
+				guesses:     []int{10, 20, 30},
+				want: analysis.Report{
+					Flagged: []analysis.Guess{},
+					Played:  3,
+					Optimal: 5,
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: 71,
+					Turns:        game.Turns{},
+				}
+				for _, guess := range tc.guesses {
+					assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+				}
+
+				got := analysis.Analyze(gameState)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("not flag a guess played again after the number drifted",
+		func(t *testing.T) {
+			greater, less, equal := game.Greater, game.Less, game.Equal
+			gameState := game.GameState{
+				Level:        "Easy",
+				MaxAttempts:  10,
+				RandomNumber: 50,
+				Variant:      game.DriftVariant,
+				Turns: game.Turns{
+					{GuessNumber: 49, Outcome: &greater},
+					{GuessNumber: 52, Outcome: &less},
+					{GuessNumber: 49, Outcome: &equal},
+				},
+			}
+
+			got := analysis.Analyze(gameState)
+
+			assert.Equal(t, analysis.Report{
+				Flagged: []analysis.Guess{},
+				Played:  3,
+			}, got)
+		})
+}
+
+func TestUnitBinarySearchGuesses(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			low         int
+			high        int
+			number      int
+			want        int
+		}{
+			{description: "middle", low: 1, high: 100, number: 50, want: 1},
+			{description: "upper half", low: 1, high: 100, number: 75, want: 2},
+			{description: "worst case", low: 1, high: 100, number: 100, want: 7},
+			{description: "single number", low: 7, high: 7, number: 7, want: 1},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got := analysis.BinarySearchGuesses(tc.low, tc.high, tc.number)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
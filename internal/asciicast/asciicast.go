@@ -0,0 +1,154 @@
+// Package asciicast records a session transcript in the asciicast v2 format,
+// so that demos and bug reports can be played back with standard tools such
+// as asciinema. A Recorder provides writers to tee the output written and the
+// input read by the game into timed events.
+package asciicast
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/timer"
+)
+
+// Version is the asciicast format version written in the header.
+const Version = 2
+
+// Default terminal size written in the header when none is given.
+const (
+	DefaultWidth  = 80
+	DefaultHeight = 24
+)
+
+// The event types of the transcript.
+const (
+	EventOutput = "o"
+	EventInput  = "i"
+)
+
+// Header is the first line of an asciicast v2 file.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Recorder writes the header and then one event per line to the writer,
+// timed from the start of the recording. With echo, the input is recorded as
+// output too, as a terminal echoes what is typed, so that it shows on
+// playback.
+// Events may be recorded concurrently, such as by the clock of the
+// full-screen view.
+type Recorder struct {
+	writer io.Writer
+	timer  timer.Timer
+	start  time.Time
+	echo   bool
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewRecorder starts a recording to the writer, writing the header with the
+// start time from the timer and the default size when none is set.
+func NewRecorder(
+	writer io.Writer,
+	t timer.Timer,
+	header Header,
+	echo bool,
+) (*Recorder, error) {
+	r := &Recorder{writer: writer, timer: t, start: t.Now(), echo: echo}
+
+	header.Version = Version
+	header.Timestamp = r.start.Unix()
+	if header.Width == 0 {
+		header.Width = DefaultWidth
+	}
+	if header.Height == 0 {
+		header.Height = DefaultHeight
+	}
+
+	if err := r.writeLine(header); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Output returns a writer recording what is written as output events.
+func (r *Recorder) Output() io.Writer {
+	return eventWriter{recorder: r, events: []string{EventOutput}}
+}
+
+// Input returns a writer recording what is written as input events, meant
+// to tee what is read from the input. With echo, it is recorded as output
+// too.
+func (r *Recorder) Input() io.Writer {
+	events := []string{EventInput}
+	if r.echo {
+		events = append(events, EventOutput)
+	}
+	return eventWriter{recorder: r, events: events}
+}
+
+// Err returns the first error writing the recording, which stops recording
+// without failing the game.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+func (r *Recorder) record(events []string, data string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return
+	}
+
+	elapsed := r.timer.Now().Sub(r.start).Round(time.Microsecond).Seconds()
+	for _, event := range events {
+		text := data
+		if event == EventOutput {
+			text = terminalNewlines.Replace(data)
+		}
+
+		if err := r.writeLine([]any{elapsed, event, text}); err != nil {
+			r.err = err
+			return
+		}
+	}
+}
+
+func (r *Recorder) writeLine(value any) error {
+	encoder := json.NewEncoder(r.writer)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(value)
+}
+
+// terminalNewlines translates newlines to the carriage return and line feed
+// a terminal outputs, which players expect.
+var terminalNewlines = strings.NewReplacer("\r\n", "\r\n", "\n", "\r\n")
+
+// eventWriter records each write as events of the given types.
+type eventWriter struct {
+	recorder *Recorder
+	events   []string
+}
+
+// Write records the bytes, never failing so that the game goes on when the
+// recording can't be written.
+func (w eventWriter) Write(p []byte) (int, error) {
+	if len(p) > 0 {
+		w.recorder.record(w.events, string(p))
+	}
+	return len(p), nil
+}
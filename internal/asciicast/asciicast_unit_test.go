@@ -0,0 +1,95 @@
+package asciicast_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/asciicast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRecorder(t *testing.T) {
+	t.Run("write the header and timed events", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		recorder, err := asciicast.NewRecorder(buffer, &StubTimer{},
+			asciicast.Header{Title: "test"},
+			false,
+		)
+		assert.NoError(t, err)
+
+		fmt.Fprint(recorder.Output(), "Welcome!\r\nEnter <your> guess:\n")
+		fmt.Fprint(recorder.Input(), "50\n")
+
+		want := strings.Join([]string{
+			`{"version":2,"width":80,"height":24,"timestamp":978310860,"title":"test"}`,
+			`[1.5,"o","Welcome!\r\nEnter <your> guess:\r\n"]`,
+			`[3,"i","50\n"]`,
+		}, "\n") + "\n"
+
+		assert.Equal(t, want, buffer.String())
+	})
+
+	t.Run("echo the input as output", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		recorder, err := asciicast.NewRecorder(buffer, &StubTimer{},
+			asciicast.Header{Width: 100, Height: 30},
+			true,
+		)
+		assert.NoError(t, err)
+
+		tee := io.TeeReader(strings.NewReader("50\n"), recorder.Input())
+		_, err = io.ReadAll(tee)
+		assert.NoError(t, err)
+
+		want := strings.Join([]string{
+			`{"version":2,"width":100,"height":30,"timestamp":978310860}`,
+			`[1.5,"i","50\n"]`,
+			`[1.5,"o","50\r\n"]`,
+		}, "\n") + "\n"
+
+		assert.Equal(t, want, buffer.String())
+	})
+
+	t.Run("keep the game going when the recording fails", func(t *testing.T) {
+		writer := &FailingWriter{}
+		recorder, err := asciicast.NewRecorder(writer, &StubTimer{},
+			asciicast.Header{},
+			false,
+		)
+		assert.NoError(t, err)
+
+		writer.fail = true
+		n, err := fmt.Fprint(recorder.Output(), "text")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, n)
+		assert.Error(t, recorder.Err())
+	})
+}
+
+type StubTimer struct {
+	calls int
+}
+
+func (s *StubTimer) Now() time.Time {
+	start := time.Date(2001, 1, 1, 1, 1, 0, 0, time.UTC)
+	elapsed := time.Duration(s.calls) * 1500 * time.Millisecond
+	s.calls++
+	return start.Add(elapsed)
+}
+
+type FailingWriter struct {
+	fail bool
+}
+
+func (w *FailingWriter) Write(p []byte) (int, error) {
+	if w.fail {
+		return 0, errors.New("disk full")
+	}
+	return len(p), nil
+}
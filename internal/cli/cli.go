@@ -8,6 +8,9 @@ import (
 	_ "embed"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
+	"strings"
 )
 
 // InputSource defines an interface for obtaining various types of user input.
@@ -20,6 +23,15 @@ type InputSource interface {
 	NextPlayAgainInput() (string, error)
 }
 
+// Input kinds, one per InputSource method, naming what the game is asking
+// for when it prompts the player.
+const (
+	InputPlayer     = "player"
+	InputDifficulty = "difficulty"
+	InputGuess      = "guess"
+	InputPlayAgain  = "play_again"
+)
+
 // CliInput implements the InputSource interface, providing methods to read
 // user input from a specified io.Reader source.
 type CliInput struct {
@@ -129,6 +141,95 @@ func GetUserInput(reader io.Reader) (string, error) {
 	return scanner.Text(), nil
 }
 
+// ClearScreen is the ANSI escape sequence clearing the terminal and its
+// scrollback.
+const ClearScreen = "\x1b[H\x1b[2J\x1b[3J"
+
+// IsTerminal reports whether the file is an interactive terminal rather than
+// a pipe or a regular file.
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// ColorEnabled reports whether styled output should be written to the file:
+// it must be a terminal and the NO_COLOR environment variable must be unset
+// or empty.
+func ColorEnabled(file *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	return IsTerminal(file)
+}
+
+// MonochromeTheme is the theme name used when the requested theme is not
+// defined in the themes config.
+const MonochromeTheme = "mono"
+
+// Theme maps output roles, such as "greater", "error" or a hint key, to ANSI
+// SGR codes like "1;32". The zero value styles nothing.
+type Theme map[string]string
+
+// NewTheme selects the theme called name from the flattened themes config,
+// whose keys read "<theme>.<role>". An unknown name falls back to the
+// monochrome theme.
+func NewTheme(themes map[string]string, name string) Theme {
+	theme := themeFrom(themes, name)
+	if len(theme) == 0 {
+		theme = themeFrom(themes, MonochromeTheme)
+	}
+
+	return theme
+}
+
+// HasTheme reports whether the flattened themes config defines the theme
+// called name.
+func HasTheme(themes map[string]string, name string) bool {
+	return len(themeFrom(themes, name)) > 0
+}
+
+// Paint wraps the text with the codes of the role, returning it unchanged
+// when the role has no codes.
+func (t Theme) Paint(role, text string) string {
+	code := t[role]
+	if code == "" || text == "" {
+		return text
+	}
+
+	return "\x1b[" + code + "m" + text + "\x1b[0m"
+}
+
+// Codes returns the codes of the role as integers, skipping invalid ones.
+func (t Theme) Codes(role string) []int {
+	var codes []int
+	for _, field := range strings.Split(t[role], ";") {
+		code, err := strconv.Atoi(field)
+		if err != nil {
+			continue
+		}
+		codes = append(codes, code)
+	}
+
+	return codes
+}
+
+func themeFrom(themes map[string]string, name string) Theme {
+	theme := Theme{}
+	for key, code := range themes {
+		role, found := strings.CutPrefix(key, name+".")
+		if found {
+			theme[role] = code
+		}
+	}
+
+	return theme
+}
+
 func validateEmptySlice(slice []string) error {
 	if len(slice) == 0 {
 		return NewEmptyError(EmptyMessage["value"])
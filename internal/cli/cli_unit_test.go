@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"bytes"
+	"os"
 	"testing"
 
 	"github.com/go-number-guessing-game/internal/cli"
@@ -168,3 +169,99 @@ func TestUnitCliInput(t *testing.T) {
 		}
 	})
 }
+
+func TestUnitIsTerminal(t *testing.T) {
+	t.Run("false for a regular file", func(t *testing.T) {
+		file, err := os.CreateTemp("", "terminal_test")
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			file.Close()
+			os.Remove(file.Name())
+		})
+
+		assert.False(t, cli.IsTerminal(file))
+	})
+}
+
+func TestUnitColorEnabled(t *testing.T) {
+	t.Run("false when NO_COLOR is set", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "1")
+
+		assert.False(t, cli.ColorEnabled(os.Stdout))
+	})
+
+	t.Run("false for a regular file", func(t *testing.T) {
+		file, err := os.CreateTemp("", "color_test")
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			file.Close()
+			os.Remove(file.Name())
+		})
+
+		assert.False(t, cli.ColorEnabled(file))
+	})
+}
+
+func TestUnitTheme(t *testing.T) {
+	themes := map[string]string{
+		"default.greater": "36",
+		"default.header":  "1;4",
+		"mono.greater":    "1",
+	}
+
+	t.Run("select theme by name", func(t *testing.T) {
+		want := cli.Theme{"greater": "36", "header": "1;4"}
+		got := cli.NewTheme(themes, "default")
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("fall back to monochrome theme", func(t *testing.T) {
+		want := cli.Theme{"greater": "1"}
+		got := cli.NewTheme(themes, "unknown")
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("paint text", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			theme       cli.Theme
+			role        string
+			want        string
+		}{
+			{
+				description: "with role codes",
+				theme:       cli.Theme{"greater": "36"},
+				role:        "greater",
+				want:        "\x1b[36mtext\x1b[0m",
+			},
+			{
+				description: "unchanged without role codes",
+				theme:       cli.Theme{"greater": "36"},
+				role:        "less",
+				want:        "text",
+			},
+			{
+				description: "unchanged with zero theme",
+				theme:       nil,
+				role:        "greater",
+				want:        "text",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got := tc.theme.Paint(tc.role, "text")
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("return role codes", func(t *testing.T) {
+		theme := cli.Theme{"header": "1;4"}
+
+		assert.Equal(t, []int{1, 4}, theme.Codes("header"))
+		assert.Nil(t, theme.Codes("greater"))
+	})
+}
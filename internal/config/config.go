@@ -5,6 +5,9 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -31,7 +34,9 @@ func NewReadConfigError(err error) error {
 }
 
 // LoadConfig reads configuration from the specified file path and type,
-// returning a map of settings. It panics on read errors.
+// returning a map of settings. Nested keys are flattened with dots, such as
+// "default.greater", and every value is read as a string. It panics on read
+// errors.
 func LoadConfig(configType string, filePath string) map[string]string {
 	v := viper.New()
 	v.SetConfigType(configType)
@@ -41,11 +46,46 @@ func LoadConfig(configType string, filePath string) map[string]string {
 		panic(NewReadConfigError(err))
 	}
 
-	config := v.AllSettings()
 	configMap := map[string]string{}
-	for k, v := range config {
-		configMap[k] = v.(string)
+	for _, k := range v.AllKeys() {
+		configMap[k] = v.GetString(k)
 	}
 
 	return configMap
 }
+
+// MergeConfig returns a copy of the base settings with the overrides applied
+// on top of them, leaving both maps unchanged.
+func MergeConfig(base, overrides map[string]string) map[string]string {
+	configMap := make(map[string]string, len(base)+len(overrides))
+	for k, v := range base {
+		configMap[k] = v
+	}
+	for k, v := range overrides {
+		configMap[k] = v
+	}
+
+	return configMap
+}
+
+// LoadLocales reads the message configs of the locales from the files of the
+// type in the directory, such as "fr.yaml", returning them keyed by locale.
+// A missing directory has no locales. It panics on read errors.
+func LoadLocales(configType string, dir string) map[string]map[string]string {
+	locales := map[string]map[string]string{}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return locales
+	}
+
+	for _, entry := range entries {
+		locale, ok := strings.CutSuffix(entry.Name(), "."+configType)
+		if entry.IsDir() || !ok {
+			continue
+		}
+		locales[locale] = LoadConfig(configType, filepath.Join(dir, entry.Name()))
+	}
+
+	return locales
+}
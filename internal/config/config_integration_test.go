@@ -2,6 +2,7 @@ package config_test
 
 import (
 	_ "embed"
+	"strings"
 	"testing"
 
 	"github.com/go-number-guessing-game/internal/config"
@@ -16,9 +17,50 @@ func TestIntegrationLoadConfig(t *testing.T) {
 		assert.Equal(t, "test", configMap["key"])
 	})
 
+	t.Run("return flattened nested config map", func(t *testing.T) {
+		configMap := config.LoadConfig("yaml", "../../configs/mock_nested.yaml")
+
+		assert.Len(t, configMap, 2)
+		assert.Equal(t, "test", configMap["section.key"])
+		assert.Equal(t, "1", configMap["section.number"])
+	})
+
 	t.Run("panic when error", func(t *testing.T) {
 		assert.Panics(t, func() {
 			config.LoadConfig("yaml", "../../configs/bad.yaml")
 		}, "want panic when loading invalid JSON data structure")
 	})
 }
+
+func TestIntegrationMergeConfig(t *testing.T) {
+	t.Run("return base config with overrides applied", func(t *testing.T) {
+		base := config.LoadConfig("yaml", "../../configs/mock.yaml")
+		overrides := map[string]string{"key": "override", "other": "value"}
+
+		got := config.MergeConfig(base, overrides)
+
+		assert.Equal(t, map[string]string{
+			"key":   "override",
+			"other": "value",
+		}, got)
+		assert.Equal(t, "test", base["key"])
+	})
+}
+
+func TestIntegrationLoadLocales(t *testing.T) {
+	t.Run("return the message configs keyed by locale", func(t *testing.T) {
+		locales := config.LoadLocales("yaml", "../../configs/locales")
+		app := config.LoadConfig("yaml", "../../configs/app.yaml")
+
+		assert.Contains(t, locales, "fr")
+		assert.NotContains(t, locales, "accessible")
+		for key, message := range locales["fr"] {
+			assert.Contains(t, app, key)
+			assert.Equal(t, strings.Count(app[key], "%"), strings.Count(message, "%"), key)
+		}
+	})
+
+	t.Run("return no locales when the directory is missing", func(t *testing.T) {
+		assert.Empty(t, config.LoadLocales("yaml", "../../configs/missing"))
+	})
+}
@@ -0,0 +1,713 @@
+// Package engine runs the rules of a round decoupled from any input or
+// output. It consumes commands and emits typed events to its subscribers,
+// such as the CLI, the JSON Lines protocol or the scores store, so that new
+// front-ends don't duplicate the game loop.
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/analysis"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/go-number-guessing-game/internal/oracle"
+	"github.com/go-number-guessing-game/internal/timer"
+)
+
+// NotStartedError represents an error occurring when a command is handled
+// before the round is started.
+type NotStartedError struct{}
+
+// Error returns a message indicating that the round must be started first.
+func (e *NotStartedError) Error() string {
+	return "Round must be started first."
+}
+
+// NewNotStartedError creates a new NotStartedError for testing.
+func NewNotStartedError() error {
+	return &NotStartedError{}
+}
+
+// GameOverError represents an error occurring when a command is handled
+// after the round is over.
+type GameOverError struct{}
+
+// Error returns a message indicating that the round is over.
+func (e *GameOverError) Error() string {
+	return "Round is already over."
+}
+
+// NewGameOverError creates a new GameOverError for testing.
+func NewGameOverError() error {
+	return &GameOverError{}
+}
+
+// NoMoreCluesError represents an error occurring when a hint is asked for
+// while every clue has already been given.
+type NoMoreCluesError struct{}
+
+// Error returns a message indicating that no clues are left.
+func (e *NoMoreCluesError) Error() string {
+	return "No more clues available."
+}
+
+// NewNoMoreCluesError creates a new NoMoreCluesError for testing.
+func NewNoMoreCluesError() error {
+	return &NoMoreCluesError{}
+}
+
+// StepsError represents an error occurring when the steps of a resumed
+// round don't match its guesses.
+type StepsError struct{}
+
+// Error returns a message indicating that the round can't be resumed.
+func (e *StepsError) Error() string {
+	return "Steps of the resumed round don't match its guesses."
+}
+
+// NewStepsError creates a new StepsError for testing.
+func NewStepsError() error {
+	return &StepsError{}
+}
+
+// CommandError represents an error occurring when the engine doesn't know
+// how to handle a command.
+type CommandError struct {
+	Command Command
+}
+
+// Error returns a message naming the unknown command type.
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("Unknown command %T.", e.Command)
+}
+
+// NewCommandError creates a new CommandError for testing.
+func NewCommandError(command Command) error {
+	return &CommandError{Command: command}
+}
+
+// Command is an action requested by a front-end.
+type Command interface {
+	isCommand()
+}
+
+// Start begins a round for the player. Min and Max bound the range of the
+// number, which is the default range when they are zero, MaxLies is the
+// number of answers that may be lies, and Variant the variant of the game.
+// Secrets holds the numbers to find in the multi variant, and Seed draws the
+// moves of the number in the drift variant. Commitment is the fairness
+// commitment to the secret of the round, if any.
+type Start struct {
+	Player       string
+	Level        string
+	MaxAttempts  int
+	RandomNumber int
+	Min          int
+	Max          int
+	MaxLies      int
+	Variant      string
+	Secrets      []int
+	Seed         uint64
+	Commitment   fairness.Commitment
+}
+
+// The steps of a round, each spending an attempt.
+const (
+	StepGuess  = "guess"
+	StepHint   = "hint"
+	StepExpire = "expire"
+)
+
+// Resume restores a suspended round by playing its steps again in order,
+// the guess steps guessing Guesses in turn, and counts the time already
+// played before the suspension. Lies holds the positions, from 1, of the
+// guesses whose answer was a lie.
+type Resume struct {
+	Player       string
+	Level        string
+	MaxAttempts  int
+	RandomNumber int
+	Min          int
+	Max          int
+	MaxLies      int
+	Variant      string
+	Secrets      []int
+	Seed         uint64
+	Commitment   fairness.Commitment
+	Steps        []string
+	Guesses      []int
+	Lies         []int
+	Elapsed      time.Duration
+}
+
+// Guess plays a turn with the guessed number. Lie forces the answer to be a
+// lie while the round may still lie, to replay a recorded round; otherwise
+// the oracle of the engine decides.
+type Guess struct {
+	Number int
+	Lie    bool
+}
+
+// SpendHint spends an attempt on an extra clue without playing a turn.
+type SpendHint struct{}
+
+// GiveUp ends the round as lost and reveals the number.
+type GiveUp struct{}
+
+// GuessTimeout wastes an attempt when the guess deadline of a time-attack
+// round passes before a guess is entered.
+type GuessTimeout struct{}
+
+// GameTimeout ends the round as lost when the game deadline of a time-attack
+// round passes.
+type GameTimeout struct{}
+
+func (Start) isCommand()        {}
+func (Resume) isCommand()       {}
+func (Guess) isCommand()        {}
+func (SpendHint) isCommand()    {}
+func (GiveUp) isCommand()       {}
+func (GuessTimeout) isCommand() {}
+func (GameTimeout) isCommand()  {}
+
+// Event is emitted to the subscribers as the round goes on.
+type Event interface {
+	isEvent()
+}
+
+// GameStarted is emitted when a round begins. Commitment is the hash of the
+// fairness commitment, empty when the round isn't committed to.
+type GameStarted struct {
+	Player      string
+	Level       string
+	MaxAttempts int
+	Commitment  string
+}
+
+// GameResumed is emitted when a suspended round is restored, with the steps
+// and turns already played.
+type GameResumed struct {
+	Player      string
+	Level       string
+	MaxAttempts int
+	Steps       []string
+	Turns       game.Turns
+	Remaining   int
+	Elapsed     time.Duration
+	Commitment  string
+}
+
+// GuessEvaluated is emitted after each guess, with the remaining attempts
+// and the interval still containing the number.
+type GuessEvaluated struct {
+	Turn      game.Turn
+	Remaining int
+	Low       int
+	High      int
+}
+
+// HintIssued is emitted after a wrong guess, with the key of the hint
+// message given by the hint strategy of the level and its arguments. The key
+// is empty when the strategy gives no hint.
+type HintIssued struct {
+	Key        string
+	Args       []any
+	Difference int
+}
+
+// ClueIssued is emitted when an attempt is spent on an extra clue, telling
+// whether the number is a multiple of Divisor. A divisor of 2 is a parity
+// clue.
+type ClueIssued struct {
+	Divisor   int
+	Multiple  bool
+	Remaining int
+}
+
+// GuessExpired is emitted when an attempt is wasted because the guess
+// deadline passed.
+type GuessExpired struct {
+	Remaining int
+}
+
+// GameWon is emitted when the number is found, with the range of the number
+// and what the attempts were spent on for scoring: HintsUsed counts the
+// clues, and Redundant the guesses that couldn't be the number. Efficiency
+// compares the guesses with a binary search, as a percentage. Secret and
+// Nonce reveal the fairness commitment, empty when the round isn't committed
+// to.
+type GameWon struct {
+	Player       string
+	Level        string
+	Variant      string
+	RandomNumber int
+	MaxAttempts  int
+	Min          int
+	Max          int
+	Attempts     int
+	HintsUsed    int
+	Redundant    int
+	Efficiency   int
+	Time         time.Duration
+	Secret       string
+	Nonce        string
+}
+
+// LiesRevealed is emitted at the end of a round allowing lies, with its
+// turns, whose Lie field tells which answers were lies.
+type LiesRevealed struct {
+	Turns   game.Turns
+	MaxLies int
+}
+
+// GameLost is emitted when the attempts run out, the player gives up or the
+// game deadline passes. Secrets holds every number of the multi variant.
+// Revealed tells that the round showed its number, which must not be played
+// again. Secret and Nonce reveal the fairness commitment, as in GameWon.
+type GameLost struct {
+	Player       string
+	Level        string
+	Variant      string
+	RandomNumber int
+	Secrets      []int
+	Attempts     int
+	Time         time.Duration
+	GaveUp       bool
+	TimedOut     bool
+	Revealed     bool
+	Secret       string
+	Nonce        string
+}
+
+func (GameStarted) isEvent()    {}
+func (GameResumed) isEvent()    {}
+func (GuessEvaluated) isEvent() {}
+func (HintIssued) isEvent()     {}
+func (ClueIssued) isEvent()     {}
+func (GuessExpired) isEvent()   {}
+func (GameWon) isEvent()        {}
+func (GameLost) isEvent()       {}
+func (LiesRevealed) isEvent()   {}
+
+// Subscriber receives the events emitted by the engine, in order.
+type Subscriber interface {
+	Notify(event Event)
+}
+
+// SubscriberFunc adapts a function to the Subscriber interface.
+type SubscriberFunc func(event Event)
+
+// Notify calls the function with the event.
+func (f SubscriberFunc) Notify(event Event) {
+	f(event)
+}
+
+// Engine runs a single round. Timer provides the current time for the round
+// duration, defaulting to the local time, and Hints the hint strategy of
+// each level, defaulting to the distance to the number. Oracle decides which
+// answers are lies in rounds allowing some, which never lie without it.
+type Engine struct {
+	Timer  timer.Timer
+	Hints  hint.Strategies
+	Oracle oracle.Oracle
+
+	subscribers []Subscriber
+	player      string
+	state       game.GameState
+	commitment  fairness.Commitment
+	steps       []string
+	gameTimer   timer.GameTimer
+	started     bool
+	over        bool
+}
+
+// Subscribe adds a subscriber notified of every following event.
+func (e *Engine) Subscribe(subscriber Subscriber) {
+	e.subscribers = append(e.subscribers, subscriber)
+}
+
+// Handle applies the command to the round, emitting the resulting events.
+// Start and Resume reset the engine for a new round. It returns an error
+// when the command isn't allowed in the current state.
+func (e *Engine) Handle(command Command) error {
+	switch command.(type) {
+	case Start, Resume:
+	default:
+		if err := e.validatePlaying(); err != nil {
+			return err
+		}
+	}
+
+	switch c := command.(type) {
+	case Start:
+		return e.start(c)
+	case Resume:
+		return e.resume(c)
+	case Guess:
+		return e.guess(c)
+	case SpendHint:
+		return e.spendHint()
+	case GiveUp:
+		e.lose(true, false)
+		return nil
+	case GuessTimeout:
+		return e.expire()
+	case GameTimeout:
+		e.lose(false, true)
+		return nil
+	default:
+		return NewCommandError(command)
+	}
+}
+
+// State returns the current game state.
+func (e *Engine) State() game.GameState {
+	return e.state
+}
+
+// Commitment returns the fairness commitment to the secret of the round,
+// the zero Commitment when the round wasn't committed to.
+func (e *Engine) Commitment() fairness.Commitment {
+	return e.commitment
+}
+
+// Player returns the player of the round.
+func (e *Engine) Player() string {
+	return e.player
+}
+
+// Over reports whether the round is won or lost.
+func (e *Engine) Over() bool {
+	return e.over
+}
+
+// Suspend returns the command resuming the round where it stands, to be
+// saved until the player comes back.
+func (e *Engine) Suspend() Resume {
+	guesses := make([]int, len(e.state.Turns))
+	for i, turn := range e.state.Turns {
+		guesses[i] = turn.GuessNumber
+	}
+
+	return Resume{
+		Player:       e.player,
+		Level:        e.state.Level,
+		MaxAttempts:  e.state.MaxAttempts,
+		RandomNumber: e.state.RandomNumber,
+		Min:          e.state.Min,
+		Max:          e.state.Max,
+		MaxLies:      e.state.MaxLies,
+		Variant:      e.state.Variant,
+		Secrets:      e.state.Secrets,
+		Seed:         e.state.Seed,
+		Commitment:   e.commitment,
+		Steps:        append([]string{}, e.steps...),
+		Guesses:      guesses,
+		Lies:         e.state.Lies(),
+		Elapsed:      e.Elapsed(),
+	}
+}
+
+// Elapsed returns the time passed since the round started.
+func (e *Engine) Elapsed() time.Duration {
+	return e.gameTimer.Elapsed()
+}
+
+func (e *Engine) start(c Start) error {
+	e.reset(c.Player, game.GameState{
+		Level:        c.Level,
+		MaxAttempts:  c.MaxAttempts,
+		RandomNumber: c.RandomNumber,
+		Min:          c.Min,
+		Max:          c.Max,
+		MaxLies:      c.MaxLies,
+		Variant:      c.Variant,
+		Secrets:      c.Secrets,
+		Seed:         c.Seed,
+	}, 0)
+	e.commitment = c.Commitment
+
+	e.publish(GameStarted{
+		Player:      c.Player,
+		Level:       c.Level,
+		MaxAttempts: c.MaxAttempts,
+		Commitment:  c.Commitment.Hash,
+	})
+	return nil
+}
+
+func (e *Engine) resume(c Resume) error {
+	e.reset(c.Player, game.GameState{
+		Level:        c.Level,
+		MaxAttempts:  c.MaxAttempts,
+		RandomNumber: c.RandomNumber,
+		Min:          c.Min,
+		Max:          c.Max,
+		MaxLies:      c.MaxLies,
+		Variant:      c.Variant,
+		Secrets:      c.Secrets,
+		Seed:         c.Seed,
+	}, c.Elapsed)
+	e.commitment = c.Commitment
+
+	if err := e.replay(c); err != nil {
+		e.started = false
+		return err
+	}
+
+	if err := e.validateResumable(); err != nil {
+		e.started = false
+		return err
+	}
+
+	e.publish(GameResumed{
+		Player:      c.Player,
+		Level:       c.Level,
+		MaxAttempts: c.MaxAttempts,
+		Steps:       e.steps,
+		Turns:       e.state.Turns,
+		Remaining:   e.remaining(),
+		Elapsed:     c.Elapsed,
+		Commitment:  c.Commitment.Hash,
+	})
+	return nil
+}
+
+// replay plays the steps of the resumed round again in order, without
+// emitting events.
+func (e *Engine) replay(c Resume) error {
+	lies := map[int]bool{}
+	for _, position := range c.Lies {
+		lies[position] = true
+	}
+
+	guesses := c.Guesses
+	for _, step := range c.Steps {
+		var err error
+		switch {
+		case step == StepGuess && len(guesses) > 0:
+			turn := game.Turn{
+				GuessNumber: guesses[0],
+				TellLie:     lies[len(e.state.Turns)+1],
+			}
+			guesses = guesses[1:]
+			err = e.state.PlayTurn(turn)
+		case step == StepHint && e.state.HintsUsed < len(hint.Divisors):
+			err = e.state.UseHint()
+		case step == StepExpire:
+			err = e.state.Expire()
+		default:
+			return NewStepsError()
+		}
+		if err != nil {
+			return err
+		}
+		e.steps = append(e.steps, step)
+	}
+	if len(guesses) > 0 {
+		return NewStepsError()
+	}
+
+	return nil
+}
+
+func (e *Engine) reset(
+	player string,
+	state game.GameState,
+	elapsed time.Duration,
+) {
+	e.player = player
+	e.state = state
+	e.state.Turns = game.Turns{}
+	e.steps = nil
+	e.started = true
+	e.over = false
+
+	e.gameTimer = timer.NewGameTimer()
+	if e.Timer != nil {
+		e.gameTimer.Timer = e.Timer
+	}
+	e.gameTimer.Offset = elapsed
+	e.gameTimer.Start()
+}
+
+func (e *Engine) guess(c Guess) error {
+	lie := c.Lie
+	if !lie && e.Oracle != nil && e.state.LiesLeft() > 0 {
+		lie = e.Oracle.Lie(e.state)
+	}
+
+	err := e.state.PlayTurn(game.Turn{GuessNumber: c.Number, TellLie: lie})
+	if err != nil {
+		return err
+	}
+	e.steps = append(e.steps, StepGuess)
+
+	turn, _ := e.state.GetLastTurn()
+	low, high := e.state.PossibleRange()
+	e.publish(GuessEvaluated{
+		Turn:      turn,
+		Remaining: e.remaining(),
+		Low:       low,
+		High:      high,
+	})
+
+	if *turn.Outcome == game.Equal {
+		e.win()
+		return nil
+	}
+
+	// Codes are only told their bulls and cows, and found secrets need no
+	// hint.
+	var given hint.Hint
+	if e.state.Variant != game.CodeVariant && *turn.Outcome != game.Hit {
+		given = e.Hints.For(e.state.Level).Hint(e.state)
+	}
+	e.publish(HintIssued{
+		Key:        given.Key,
+		Args:       given.Args,
+		Difference: *turn.Difference,
+	})
+
+	if e.state.NoMoreAttempts() {
+		e.lose(false, false)
+	}
+	return nil
+}
+
+func (e *Engine) spendHint() error {
+	if e.state.HintsUsed >= len(hint.Divisors) {
+		return NewNoMoreCluesError()
+	}
+
+	divisor := hint.Divisors[e.state.HintsUsed]
+	if err := e.state.UseHint(); err != nil {
+		return err
+	}
+	e.steps = append(e.steps, StepHint)
+
+	e.publish(ClueIssued{
+		Divisor:   divisor,
+		Multiple:  e.state.Target()%divisor == 0,
+		Remaining: e.remaining(),
+	})
+
+	if e.state.NoMoreAttempts() {
+		e.lose(false, false)
+	}
+	return nil
+}
+
+func (e *Engine) expire() error {
+	if err := e.state.Expire(); err != nil {
+		return err
+	}
+	e.steps = append(e.steps, StepExpire)
+
+	e.publish(GuessExpired{Remaining: e.remaining()})
+
+	if e.state.NoMoreAttempts() {
+		e.lose(false, false)
+	}
+	return nil
+}
+
+func (e *Engine) win() {
+	e.over = true
+	low, high := e.state.Bounds()
+	e.publish(GameWon{
+		Player:       e.player,
+		Level:        e.state.Level,
+		Variant:      e.state.Variant,
+		RandomNumber: e.state.Position(),
+		MaxAttempts:  e.state.MaxAttempts,
+		Min:          low,
+		Max:          high,
+		Attempts:     e.state.GetAttempts(),
+		HintsUsed:    e.state.HintsUsed,
+		Redundant:    e.state.RedundantTurns(),
+		Efficiency:   analysis.Analyze(e.state).Efficiency,
+		Time:         e.gameTimer.End(),
+		Secret:       e.secret(),
+		Nonce:        e.commitment.Nonce,
+	})
+	e.revealLies()
+}
+
+func (e *Engine) lose(gaveUp, timedOut bool) {
+	e.over = true
+
+	// Giving up, timing out, the multi variant and the fairness reveal all
+	// show the secret of the round.
+	revealed := gaveUp || timedOut || len(e.state.Secrets) > 0 ||
+		e.commitment.Hash != ""
+	e.publish(GameLost{
+		Player:       e.player,
+		Level:        e.state.Level,
+		Variant:      e.state.Variant,
+		RandomNumber: e.state.Position(),
+		Secrets:      e.state.Secrets,
+		Attempts:     e.state.GetAttempts(),
+		Time:         e.gameTimer.End(),
+		GaveUp:       gaveUp,
+		TimedOut:     timedOut,
+		Revealed:     revealed,
+		Secret:       e.secret(),
+		Nonce:        e.commitment.Nonce,
+	})
+	e.revealLies()
+}
+
+// secret returns the secret of the round to reveal with the nonce of its
+// fairness commitment, and nothing when the round isn't committed to.
+func (e *Engine) secret() string {
+	if e.commitment.Hash == "" {
+		return ""
+	}
+	return e.state.Secret()
+}
+
+func (e *Engine) revealLies() {
+	if e.state.MaxLies == 0 {
+		return
+	}
+
+	e.publish(LiesRevealed{Turns: e.state.Turns, MaxLies: e.state.MaxLies})
+}
+
+func (e *Engine) remaining() int {
+	return e.state.MaxAttempts - e.state.GetAttempts()
+}
+
+func (e *Engine) validateResumable() error {
+	if turn, err := e.state.GetLastTurn(); err == nil &&
+		*turn.Outcome == game.Equal {
+		return game.NewRandomNumberFoundError()
+	}
+
+	if e.state.NoMoreAttempts() {
+		return game.NewNoMoreAttemptsError()
+	}
+
+	return nil
+}
+
+func (e *Engine) validatePlaying() error {
+	if !e.started {
+		return NewNotStartedError()
+	}
+
+	if e.over {
+		return NewGameOverError()
+	}
+
+	return nil
+}
+
+func (e *Engine) publish(event Event) {
+	for _, subscriber := range e.subscribers {
+		subscriber.Notify(event)
+	}
+}
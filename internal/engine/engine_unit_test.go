@@ -0,0 +1,574 @@
+package engine_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/go-number-guessing-game/internal/oracle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEngineHandle(t *testing.T) {
+	t.Run("emit events for a won round", func(t *testing.T) {
+		round, events := startEngine(t, "Hard", 3)
+
+		assert.NoError(t, round.Handle(engine.Guess{Number: 48}))
+		assert.NoError(t, round.Handle(engine.Guess{Number: 50}))
+
+		want := []engine.Event{
+			engine.GameStarted{Player: "test", Level: "Hard", MaxAttempts: 3},
+			engine.GuessEvaluated{
+				Turn: game.Turn{
+					GuessNumber: 48,
+					Outcome:     toOutcome(game.Greater),
+					Difference:  toPointer(2),
+				},
+				Remaining: 2,
+				Low:       49,
+				High:      100,
+			},
+			engine.HintIssued{Key: "very_close_2", Difference: 2},
+			engine.GuessEvaluated{
+				Turn: game.Turn{
+					GuessNumber: 50,
+					Outcome:     toOutcome(game.Equal),
+					Difference:  toPointer(0),
+				},
+				Remaining: 1,
+				Low:       50,
+				High:      50,
+			},
+			engine.GameWon{
+				Player:       "test",
+				Level:        "Hard",
+				RandomNumber: 50,
+				MaxAttempts:  3,
+				Min:          1,
+				Max:          100,
+				Attempts:     2,
+				Efficiency:   50,
+				Time:         10 * time.Second,
+			},
+		}
+
+		assert.Equal(t, want, *events)
+		assert.True(t, round.Over())
+	})
+
+	t.Run("emit game lost when no more attempts", func(t *testing.T) {
+		round, events := startEngine(t, "Hard", 3)
+
+		for _, number := range []int{10, 20, 30} {
+			assert.NoError(t, round.Handle(engine.Guess{Number: number}))
+		}
+
+		last := (*events)[len(*events)-1]
+		assert.Equal(t, engine.GameLost{
+			Player:       "test",
+			Level:        "Hard",
+			RandomNumber: 50,
+			Attempts:     3,
+			Time:         10 * time.Second,
+		}, last)
+		assert.True(t, round.Over())
+	})
+
+	t.Run("reveal the number of a lost round committed to", func(t *testing.T) {
+		var events []engine.Event
+		round := &engine.Engine{Timer: &StubTimer{}}
+		round.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+			events = append(events, event)
+		}))
+		commitment, err := fairness.Commit("50")
+		assert.NoError(t, err)
+
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			Commitment:   commitment,
+		}))
+		for _, number := range []int{10, 20, 30} {
+			assert.NoError(t, round.Handle(engine.Guess{Number: number}))
+		}
+
+		assert.Equal(t, commitment, round.Commitment())
+		assert.Equal(t, commitment.Hash, events[0].(engine.GameStarted).Commitment)
+		lost := events[len(events)-1].(engine.GameLost)
+		assert.True(t, lost.Revealed)
+		assert.Equal(t, "50", lost.Secret)
+		assert.Equal(t, commitment.Nonce, lost.Nonce)
+	})
+
+	t.Run("emit game lost when giving up", func(t *testing.T) {
+		round, events := startEngine(t, "Hard", 3)
+
+		assert.NoError(t, round.Handle(engine.GiveUp{}))
+
+		last := (*events)[len(*events)-1]
+		assert.Equal(t, engine.GameLost{
+			Player:       "test",
+			Level:        "Hard",
+			RandomNumber: 50,
+			Time:         10 * time.Second,
+			GaveUp:       true,
+			Revealed:     true,
+		}, last)
+	})
+
+	t.Run("emit clues for spent hints", func(t *testing.T) {
+		round, events := startEngine(t, "Easy", 10)
+
+		for range hint.Divisors {
+			assert.NoError(t, round.Handle(engine.SpendHint{}))
+		}
+
+		want := []engine.Event{
+			engine.ClueIssued{Divisor: 2, Multiple: true, Remaining: 9},
+			engine.ClueIssued{Divisor: 3, Multiple: false, Remaining: 8},
+			engine.ClueIssued{Divisor: 5, Multiple: true, Remaining: 7},
+			engine.ClueIssued{Divisor: 7, Multiple: false, Remaining: 6},
+		}
+		assert.Equal(t, want, (*events)[1:])
+
+		wantErr := engine.NewNoMoreCluesError()
+		gotErr := round.Handle(engine.SpendHint{})
+		assert.ErrorAs(t, gotErr, &wantErr)
+	})
+
+	t.Run("waste attempts when guesses expire", func(t *testing.T) {
+		round, events := startEngine(t, "Hard", 3)
+
+		for range 3 {
+			assert.NoError(t, round.Handle(engine.GuessTimeout{}))
+		}
+
+		want := []engine.Event{
+			engine.GuessExpired{Remaining: 2},
+			engine.GuessExpired{Remaining: 1},
+			engine.GuessExpired{Remaining: 0},
+			engine.GameLost{
+				Player:       "test",
+				Level:        "Hard",
+				RandomNumber: 50,
+				Attempts:     3,
+				Time:         10 * time.Second,
+			},
+		}
+		assert.Equal(t, want, (*events)[1:])
+	})
+
+	t.Run("emit game lost when the game times out", func(t *testing.T) {
+		round, events := startEngine(t, "Hard", 3)
+
+		assert.NoError(t, round.Handle(engine.GameTimeout{}))
+
+		last := (*events)[len(*events)-1]
+		assert.Equal(t, engine.GameLost{
+			Player:       "test",
+			Level:        "Hard",
+			RandomNumber: 50,
+			Time:         10 * time.Second,
+			TimedOut:     true,
+			Revealed:     true,
+		}, last)
+		assert.True(t, round.Over())
+	})
+
+	t.Run("error when not started", func(t *testing.T) {
+		round := &engine.Engine{}
+
+		want := engine.NewNotStartedError()
+		got := round.Handle(engine.Guess{Number: 50})
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+
+	t.Run("error when round is over", func(t *testing.T) {
+		round, _ := startEngine(t, "Hard", 3)
+		assert.NoError(t, round.Handle(engine.Guess{Number: 50}))
+
+		want := engine.NewGameOverError()
+		got := round.Handle(engine.Guess{Number: 50})
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+
+	t.Run("play an adaptive round within its range", func(t *testing.T) {
+		var events []engine.Event
+		round := &engine.Engine{Timer: &StubTimer{}}
+		round.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+			events = append(events, event)
+		}))
+
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        game.AdaptiveLevel,
+			MaxAttempts:  6,
+			RandomNumber: 150,
+			Min:          1,
+			Max:          200,
+		}))
+		assert.NoError(t, round.Handle(engine.Guess{Number: 120}))
+		assert.NoError(t, round.Handle(engine.Guess{Number: 150}))
+
+		evaluated := events[1].(engine.GuessEvaluated)
+		assert.Equal(t, 121, evaluated.Low)
+		assert.Equal(t, 200, evaluated.High)
+
+		won := events[len(events)-1].(engine.GameWon)
+		assert.Equal(t, 6, won.MaxAttempts)
+		assert.Equal(t, 1, won.Min)
+		assert.Equal(t, 200, won.Max)
+	})
+
+	t.Run("lie with the oracle and reveal the lies", func(t *testing.T) {
+		var events []engine.Event
+		round := &engine.Engine{
+			Timer:  &StubTimer{},
+			Oracle: oracle.Liar{Rate: 1},
+		}
+		round.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+			events = append(events, event)
+		}))
+
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			MaxLies:      1,
+		}))
+		for _, number := range []int{40, 45, 50} {
+			assert.NoError(t, round.Handle(engine.Guess{Number: number}))
+		}
+
+		evaluated := events[1].(engine.GuessEvaluated)
+		assert.Equal(t, game.Less, *evaluated.Turn.Outcome)
+		assert.True(t, evaluated.Turn.Lied)
+
+		evaluated = events[3].(engine.GuessEvaluated)
+		assert.Equal(t, game.Greater, *evaluated.Turn.Outcome)
+		assert.False(t, evaluated.Turn.Lied)
+
+		revealed := events[len(events)-1].(engine.LiesRevealed)
+		assert.Equal(t, 1, revealed.MaxLies)
+		assert.True(t, revealed.Turns[0].Lied)
+		assert.Equal(t, []int{1}, round.Suspend().Lies)
+	})
+
+	t.Run("find every hidden number of the multi variant", func(t *testing.T) {
+		var events []engine.Event
+		round := &engine.Engine{Timer: &StubTimer{}}
+		round.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+			events = append(events, event)
+		}))
+
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 20,
+			Variant:      game.MultiVariant,
+			Secrets:      []int{20, 60},
+		}))
+		for _, number := range []int{55, 60, 20} {
+			assert.NoError(t, round.Handle(engine.Guess{Number: number}))
+		}
+
+		assert.Equal(t, engine.HintIssued{Key: "close_2", Difference: 5}, events[2])
+
+		hit := events[3].(engine.GuessEvaluated)
+		assert.Equal(t, game.Hit, *hit.Turn.Outcome)
+		assert.Equal(t, 1, hit.Turn.Below)
+		assert.Equal(t, engine.HintIssued{}, events[4])
+
+		won := events[len(events)-1].(engine.GameWon)
+		assert.Equal(t, game.MultiVariant, won.Variant)
+		assert.Equal(t, 3, won.Attempts)
+	})
+
+	t.Run("reveal every hidden number of a lost multi round", func(t *testing.T) {
+		var events []engine.Event
+		round := &engine.Engine{Timer: &StubTimer{}}
+		round.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+			events = append(events, event)
+		}))
+
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 20,
+			Variant:      game.MultiVariant,
+			Secrets:      []int{20, 60},
+		}))
+		for _, number := range []int{55, 50, 45} {
+			assert.NoError(t, round.Handle(engine.Guess{Number: number}))
+		}
+
+		lost := events[len(events)-1].(engine.GameLost)
+		assert.Equal(t, []int{20, 60}, lost.Secrets)
+		assert.True(t, lost.Revealed)
+	})
+}
+
+func TestUnitEngineResume(t *testing.T) {
+	t.Run("resume a suspended round", func(t *testing.T) {
+		round, _ := startEngine(t, "Hard", 3)
+		assert.NoError(t, round.Handle(engine.Guess{Number: 40}))
+		suspended := round.Suspend()
+
+		events := []engine.Event{}
+		resumed := &engine.Engine{Timer: &StubTimer{}}
+		resumed.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+			events = append(events, event)
+		}))
+
+		assert.NoError(t, resumed.Handle(suspended))
+		assert.NoError(t, resumed.Handle(engine.Guess{Number: 50}))
+
+		want := []engine.Event{
+			engine.GameResumed{
+				Player:      "test",
+				Level:       "Hard",
+				MaxAttempts: 3,
+				Steps:       []string{engine.StepGuess},
+				Turns: game.Turns{{
+					GuessNumber: 40,
+					Outcome:     toOutcome(game.Greater),
+					Difference:  toPointer(10),
+				}},
+				Remaining: 2,
+				Elapsed:   10 * time.Second,
+			},
+			engine.GuessEvaluated{
+				Turn: game.Turn{
+					GuessNumber: 50,
+					Outcome:     toOutcome(game.Equal),
+					Difference:  toPointer(0),
+				},
+				Remaining: 1,
+				Low:       50,
+				High:      50,
+			},
+			engine.GameWon{
+				Player:       "test",
+				Level:        "Hard",
+				RandomNumber: 50,
+				MaxAttempts:  3,
+				Min:          1,
+				Max:          100,
+				Attempts:     2,
+				Efficiency:   50,
+				Time:         20 * time.Second,
+			},
+		}
+		assert.Equal(t, want, events)
+	})
+
+	t.Run("resume a drift round where the number moved", func(t *testing.T) {
+		round := &engine.Engine{Timer: &StubTimer{}}
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			Variant:      game.DriftVariant,
+			Seed:         7,
+		}))
+		for _, number := range []int{10, 90, 30} {
+			assert.NoError(t, round.Handle(engine.Guess{Number: number}))
+		}
+		suspended := round.Suspend()
+		assert.Equal(t, uint64(7), suspended.Seed)
+		assert.Equal(t, 50, suspended.RandomNumber)
+
+		resumed := &engine.Engine{Timer: &StubTimer{}}
+		assert.NoError(t, resumed.Handle(suspended))
+
+		gotState, wantState := resumed.State(), round.State()
+		assert.Equal(t, wantState.Turns, gotState.Turns)
+		assert.Equal(t, wantState.Position(), gotState.Position())
+	})
+
+	t.Run("suspend the steps of the round in order", func(t *testing.T) {
+		round, _ := startEngine(t, "Easy", 10)
+		assert.NoError(t, round.Handle(engine.Guess{Number: 40}))
+		assert.NoError(t, round.Handle(engine.SpendHint{}))
+		assert.NoError(t, round.Handle(engine.Guess{Number: 45}))
+
+		want := engine.Resume{
+			Player:       "test",
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			Steps: []string{
+				engine.StepGuess, engine.StepHint, engine.StepGuess,
+			},
+			Guesses: []int{40, 45},
+			Elapsed: 10 * time.Second,
+		}
+		assert.Equal(t, want, round.Suspend())
+	})
+
+	t.Run("replay the steps of the round in order", func(t *testing.T) {
+		round, _ := startEngine(t, "Easy", 10)
+		assert.NoError(t, round.Handle(engine.Guess{Number: 40}))
+		assert.NoError(t, round.Handle(engine.SpendHint{}))
+		assert.NoError(t, round.Handle(engine.GuessTimeout{}))
+		assert.NoError(t, round.Handle(engine.Guess{Number: 45}))
+		suspended := round.Suspend()
+
+		resumed := &engine.Engine{Timer: &StubTimer{}}
+		assert.NoError(t, resumed.Handle(suspended))
+
+		assert.Equal(t, suspended.Steps, resumed.Suspend().Steps)
+		gotState, wantState := resumed.State(), round.State()
+		assert.Equal(t, wantState.Turns, gotState.Turns)
+		assert.Equal(t, 1, gotState.HintsUsed)
+		assert.Equal(t, 1, gotState.Expired)
+	})
+
+	t.Run("error when the steps don't match the guesses", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			steps   []string
+			guesses []int
+		}{
+			{
+				name:    "guess step without a guess",
+				steps:   []string{engine.StepGuess, engine.StepGuess},
+				guesses: []int{40},
+			},
+			{
+				name:    "guess without a guess step",
+				steps:   []string{engine.StepHint},
+				guesses: []int{40},
+			},
+			{
+				name:  "unknown step",
+				steps: []string{"skip"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				round := &engine.Engine{Timer: &StubTimer{}}
+
+				got := round.Handle(engine.Resume{
+					Player:       "test",
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: 50,
+					Steps:        tc.steps,
+					Guesses:      tc.guesses,
+				})
+
+				want := engine.NewStepsError()
+				assert.ErrorAs(t, got, &want)
+				assert.Equal(t, want.Error(), got.Error())
+			})
+		}
+	})
+
+	t.Run("error when the round was already over", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			guesses []int
+			want    error
+		}{
+			{
+				name:    "number found",
+				guesses: []int{50},
+				want:    game.NewRandomNumberFoundError(),
+			},
+			{
+				name:    "no more attempts",
+				guesses: []int{10, 20, 30},
+				want:    game.NewNoMoreAttemptsError(),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				round := &engine.Engine{Timer: &StubTimer{}}
+
+				got := round.Handle(engine.Resume{
+					Player:       "test",
+					Level:        "Hard",
+					MaxAttempts:  3,
+					RandomNumber: 50,
+					Steps:        guessSteps(len(tc.guesses)),
+					Guesses:      tc.guesses,
+				})
+
+				assert.NotNil(t, got)
+				assert.ErrorAs(t, got, &tc.want)
+
+				notStarted := engine.NewNotStartedError()
+				err := round.Handle(engine.Guess{Number: 50})
+				assert.ErrorAs(t, err, &notStarted)
+			})
+		}
+	})
+}
+
+func startEngine(
+	t *testing.T,
+	level string,
+	maxAttempts int,
+) (*engine.Engine, *[]engine.Event) {
+	t.Helper()
+
+	events := &[]engine.Event{}
+	round := &engine.Engine{Timer: &StubTimer{}}
+	round.Subscribe(engine.SubscriberFunc(func(event engine.Event) {
+		*events = append(*events, event)
+	}))
+
+	err := round.Handle(engine.Start{
+		Player:       "test",
+		Level:        level,
+		MaxAttempts:  maxAttempts,
+		RandomNumber: 50,
+	})
+	assert.NoError(t, err)
+
+	return round, events
+}
+
+type StubTimer struct {
+	calls int
+}
+
+func (s *StubTimer) Now() time.Time {
+	if s.calls == 0 {
+		s.calls++
+		return time.Date(2001, 1, 1, 1, 1, 0, 0, time.UTC)
+	}
+	return time.Date(2001, 1, 1, 1, 1, 10, 0, time.UTC)
+}
+
+func guessSteps(count int) []string {
+	steps := []string{}
+	for range count {
+		steps = append(steps, engine.StepGuess)
+	}
+	return steps
+}
+
+func toPointer(value int) *int {
+	return &value
+}
+
+func toOutcome(value game.Outcome) *game.Outcome {
+	return &value
+}
@@ -0,0 +1,52 @@
+// Package fairness proves that the secret of a round never changed, with a
+// commit-reveal scheme: the hash of the secret and a random nonce is shown
+// when the round starts, and the secret and nonce are revealed at its end,
+// so that anyone can hash them again and compare.
+package fairness
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// NonceSize is the number of random bytes of a nonce, enough that the
+// secret can't be found by hashing every possible secret.
+const NonceSize = 16
+
+// Commitment is the hash committing to a secret, with the nonce hashed along
+// the secret, both hex encoded. The nonce must stay hidden until the secret
+// is revealed.
+type Commitment struct {
+	Hash  string `json:"hash"`
+	Nonce string `json:"nonce"`
+}
+
+// Commit commits to the secret with a new random nonce.
+func Commit(secret string) (Commitment, error) {
+	nonce := make([]byte, NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return Commitment{}, err
+	}
+
+	encoded := hex.EncodeToString(nonce)
+	return Commitment{Hash: Hash(secret, encoded), Nonce: encoded}, nil
+}
+
+// Hash returns the SHA-256 hash of the secret, a colon and the nonce, hex
+// encoded, the same as `printf '42:<nonce>' | sha256sum` for the secret 42.
+func Hash(secret, nonce string) string {
+	sum := sha256.Sum256([]byte(secret + ":" + nonce))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify reports whether the hash commits to the secret with the nonce.
+func Verify(hash, secret, nonce string) bool {
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(Hash(secret, nonce))) == 1
+}
+
+// Verify reports whether the commitment was made to the secret.
+func (c Commitment) Verify(secret string) bool {
+	return c.Hash != "" && Verify(c.Hash, secret, c.Nonce)
+}
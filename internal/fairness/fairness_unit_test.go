@@ -0,0 +1,62 @@
+package fairness_test
+
+import (
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCommit(t *testing.T) {
+	t.Run("commit to the secret with a random nonce", func(t *testing.T) {
+		first, err := fairness.Commit("42")
+		assert.NoError(t, err)
+		second, err := fairness.Commit("42")
+		assert.NoError(t, err)
+
+		assert.Len(t, first.Nonce, 2*fairness.NonceSize)
+		assert.Equal(t, fairness.Hash("42", first.Nonce), first.Hash)
+		assert.NotEqual(t, first.Nonce, second.Nonce)
+		assert.NotEqual(t, first.Hash, second.Hash)
+	})
+}
+
+func TestUnitHash(t *testing.T) {
+	t.Run("hash the secret, a colon and the nonce with SHA-256", func(t *testing.T) {
+		assert.Equal(t,
+			"2fc9bca56a7180498de7668ae45d92dead6c2e2362cd9886fec9490eb43fb2d3",
+			fairness.Hash("42", "00"),
+		)
+	})
+}
+
+func TestUnitVerify(t *testing.T) {
+	commitment, err := fairness.Commit("42")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		hash   string
+		secret string
+		nonce  string
+		want   bool
+	}{
+		{"verify the revealed secret", commitment.Hash, "42", commitment.Nonce, true},
+		{"reject another secret", commitment.Hash, "43", commitment.Nonce, false},
+		{"reject another nonce", commitment.Hash, "42", "00", false},
+		{"reject another hash", fairness.Hash("42", "00"), "42", commitment.Nonce, false},
+		{"reject an empty hash", "", "42", commitment.Nonce, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, fairness.Verify(tc.hash, tc.secret, tc.nonce))
+		})
+	}
+
+	t.Run("verify the commitment against the secret", func(t *testing.T) {
+		assert.True(t, commitment.Verify("42"))
+		assert.False(t, commitment.Verify("43"))
+		assert.False(t, fairness.Commitment{}.Verify("42"))
+	})
+}
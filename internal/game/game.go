@@ -5,7 +5,10 @@ package game
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"math/rand/v2"
+	"strconv"
+	"strings"
 )
 
 // TurnsLengthError represents an error occurring when the number of turns
@@ -34,7 +37,7 @@ type LevelError struct{}
 
 // Error returns a message indicating the valid levels for the game.
 func (e *LevelError) Error() string {
-	return `Level must be "Easy", "Medium" or "Hard".`
+	return `Level must be "Easy", "Medium", "Hard" or "Adaptive".`
 }
 
 // NewLevelError creates a new LevelError for testing.
@@ -48,7 +51,7 @@ type MaxAttemptsError struct{}
 
 // Error returns a message indicating the valid maximum attempts for each level.
 func (e *MaxAttemptsError) Error() string {
-	return "Max attempts must be 10 (Easy), 5 (Medium) or 3 (Hard)."
+	return "Max attempts must be 10 (Easy), 5 (Medium), 3 (Hard) or at least 1 (Adaptive)."
 }
 
 // NewMaxAttemptsError creates a new MaxAttemptsError for testing.
@@ -70,6 +73,66 @@ func NewRandomNumberFoundError() error {
 	return &RandomNumberFoundError{}
 }
 
+// SecretsError represents an error occurring when the secrets of the multi
+// variant can't be drawn.
+type SecretsError struct{}
+
+// Error returns a message indicating the valid number of secrets.
+func (e *SecretsError) Error() string {
+	return fmt.Sprintf("Secrets must be %d to %d distinct numbers of the range.",
+		MinSecrets,
+		MaxSecrets,
+	)
+}
+
+// NewSecretsError creates a new SecretsError for testing.
+func NewSecretsError() error {
+	return &SecretsError{}
+}
+
+// CodeDigitsError represents an error occurring when the codes of the code
+// variant can't have the number of digits.
+type CodeDigitsError struct{}
+
+// Error returns a message indicating the valid number of digits.
+func (e *CodeDigitsError) Error() string {
+	return fmt.Sprintf("Codes must have %d to %d digits.",
+		MinCodeDigits,
+		MaxCodeDigits,
+	)
+}
+
+// NewCodeDigitsError creates a new CodeDigitsError for testing.
+func NewCodeDigitsError() error {
+	return &CodeDigitsError{}
+}
+
+// VariantError represents an error occurring when no variant of the game
+// has the name.
+type VariantError struct {
+	Name string
+}
+
+// VariantMessage is the message displayed when the variant is unknown. It
+// is public for testing purposes.
+const VariantMessage = "No variant is named %q: choose %s, %s, %s or %s."
+
+// Error returns a message listing the names of the variants.
+func (e *VariantError) Error() string {
+	return fmt.Sprintf(VariantMessage,
+		e.Name,
+		NumberVariant,
+		CodeVariant,
+		MultiVariant,
+		DriftVariant,
+	)
+}
+
+// NewVariantError creates a new VariantError for testing.
+func NewVariantError(name string) error {
+	return &VariantError{Name: name}
+}
+
 // EmptyTurnsError represents an error occurring when the turns slice is empty.
 type EmptyTurnsError struct{}
 
@@ -83,29 +146,282 @@ func NewEmptyTurnsError() error {
 	return &EmptyTurnsError{}
 }
 
+// NoMoreAttemptsError represents an error occurring when an attempt is spent
+// while none are left.
+type NoMoreAttemptsError struct{}
+
+// Error returns a message indicating that no attempts are left.
+func (e *NoMoreAttemptsError) Error() string {
+	return "No more attempts left."
+}
+
+// NewNoMoreAttemptsError creates a new NoMoreAttemptsError for testing.
+func NewNoMoreAttemptsError() error {
+	return &NoMoreAttemptsError{}
+}
+
+// MinNumber and MaxNumber bound the range the random number is drawn from.
+const (
+	MinNumber = 1
+	MaxNumber = 100
+)
+
+// AdaptiveLevel is the level whose maximum attempts and range are tuned to
+// the player between rounds, rather than fixed.
+const AdaptiveLevel = "Adaptive"
+
+// CodeVariant is the variant of the game where the number is a code of
+// digits, and each guess is told how many of its digits are in the right
+// place (bulls) or in the code at another place (cows), rather than whether
+// the number is greater or less.
+const CodeVariant = "code"
+
+// MinCodeDigits, MaxCodeDigits and DefaultCodeDigits bound the number of
+// digits of a code, whose largest codes must be saved as 32-bit numbers.
+const (
+	MinCodeDigits     = 1
+	MaxCodeDigits     = 9
+	DefaultCodeDigits = 4
+)
+
+// MultiVariant is the variant of the game where several secret numbers are
+// hidden in the range, each guess being told the direction of the nearest
+// secret not found yet, and the round is won once they are all found.
+const MultiVariant = "multi"
+
+// MinSecrets, MaxSecrets and DefaultSecrets bound the number of secrets of
+// the multi variant.
+const (
+	MinSecrets     = 2
+	MaxSecrets     = 5
+	DefaultSecrets = 3
+)
+
+// DriftVariant is the variant of the game where the number moves by at most
+// DriftStep after every wrong guess, the answer telling where it is now. The
+// moves are drawn from the seed of the round, so that it plays again the
+// same.
+const DriftVariant = "drift"
+
+// DriftStep bounds the move of the number after a wrong guess in the drift
+// variant.
+const DriftStep = 3
+
+// NumberVariant names the classic game, whose Variant is empty.
+const NumberVariant = "number"
+
+// ParseVariant returns the Variant of the game named name, which is empty
+// for NumberVariant. It returns a VariantError when no variant has the name.
+func ParseVariant(name string) (string, error) {
+	switch name {
+	case NumberVariant:
+		return "", nil
+	case CodeVariant, MultiVariant, DriftVariant:
+		return name, nil
+	}
+	return "", NewVariantError(name)
+}
+
+// NewSecrets draws count distinct secret numbers between min and max, the
+// first being first when it is in the range. It returns a SecretsError when
+// count is out of bounds or the range too small.
+func NewSecrets(count, first, min, max int) ([]int, error) {
+	if count < MinSecrets || count > MaxSecrets || max-min+1 < count {
+		return nil, NewSecretsError()
+	}
+
+	secrets := []int{}
+	drawn := map[int]bool{}
+	if first >= min && first <= max {
+		secrets = append(secrets, first)
+		drawn[first] = true
+	}
+	for len(secrets) < count {
+		number := NewRandomNumberBetween(min, max)
+		if !drawn[number] {
+			secrets = append(secrets, number)
+			drawn[number] = true
+		}
+	}
+
+	return secrets, nil
+}
+
+// CodeBounds returns the range of the codes with the number of digits, from
+// MinCodeDigits to MaxCodeDigits, which don't start with a zero so that
+// every code is written with them all.
+func CodeBounds(digits int) (int, int) {
+	low := int(math.Pow10(digits - 1))
+	return low, low*10 - 1
+}
+
 // NewRandomNumber generates and returns a new random number between 1 and 100.
 func NewRandomNumber() int {
-	return rand.IntN(100) + 1
+	return NewRandomNumberBetween(MinNumber, MaxNumber)
+}
+
+// NewRandomNumberBetween generates and returns a new random number between
+// min and max, both included.
+func NewRandomNumberBetween(min, max int) int {
+	return rand.IntN(max-min+1) + min
 }
 
-// Turn represents a single turn in the game, it holds the guessed number,
-// the outcome of the guess, and the difference from the random number.
+// OptimalGuesses returns the number of guesses a binary search needs to find
+// any number between low and high, in the worst case.
+func OptimalGuesses(low, high int) int {
+	if high < low {
+		return 0
+	}
+	return bits.Len(uint(high - low + 1))
+}
+
+// Outcome tells how the random number compares to a guessed number.
+type Outcome int
+
+// Outcomes of a turn, from the point of view of the random number. Mismatch
+// is the outcome of a wrong guess of a code, which is neither greater nor
+// less, and Hit of a guess finding a secret of the multi variant while
+// others are left.
+const (
+	Less     Outcome = -1
+	Equal    Outcome = 0
+	Greater  Outcome = 1
+	Mismatch Outcome = 2
+	Hit      Outcome = 3
+)
+
+// String returns "greater" or "less" when the random number is greater or
+// less than the guess, "mismatch" for a wrong code, "hit" for a secret found
+// while others are left, and "equal" when it was found.
+func (o Outcome) String() string {
+	switch o {
+	case Greater:
+		return "greater"
+	case Less:
+		return "less"
+	case Mismatch:
+		return "mismatch"
+	case Hit:
+		return "hit"
+	default:
+		return "equal"
+	}
+}
+
+// Turn is a guess and its outcome. TellLie asks for the outcome to be a lie,
+// and Lied tells whether it was one. Bulls and Cows score a code, Above and
+// Below count the secrets left, and Position is where a drifting number
+// moved.
 type Turn struct {
 	GuessNumber int
-	Outcome     *int
+	Outcome     *Outcome
 	Difference  *int
+	TellLie     bool
+	Lied        bool
+	Bulls       int
+	Cows        int
+	Above       int
+	Below       int
+	Position    int
 }
 
 // Turns holds a collection of turns.
 type Turns []Turn
 
-// GameState holds the current state of the game, including the level,
-// maximum attempts, the random number, and the turns taken.
+// GameState holds the state of a round. HintsUsed and Expired count the
+// attempts spent on clues and passed deadlines. Min and Max bound the
+// number, MinNumber and MaxNumber when zero, and MaxLies caps the lies of
+// the outcomes. Secrets are the numbers of the multi variant, and Seed
+// draws the moves of the drift variant.
 type GameState struct {
 	Level        string
 	MaxAttempts  int
 	RandomNumber int
 	Turns        Turns
+	HintsUsed    int
+	Expired      int
+	Min          int
+	Max          int
+	MaxLies      int
+	Variant      string
+	Secrets      []int
+	Seed         uint64
+}
+
+// Bounds returns the range of the random number.
+func (gs *GameState) Bounds() (int, int) {
+	if gs.Min == 0 && gs.Max == 0 {
+		return MinNumber, MaxNumber
+	}
+	return gs.Min, gs.Max
+}
+
+// Unfound returns the secrets of the multi variant not guessed yet, in
+// order.
+func (gs *GameState) Unfound() []int {
+	guessed := map[int]bool{}
+	for _, turn := range gs.Turns {
+		guessed[turn.GuessNumber] = true
+	}
+
+	var unfound []int
+	for _, secret := range gs.Secrets {
+		if !guessed[secret] {
+			unfound = append(unfound, secret)
+		}
+	}
+	return unfound
+}
+
+// Position returns where the random number stands, which is where it last
+// moved in the drift variant.
+func (gs *GameState) Position() int {
+	turn, err := gs.GetLastTurn()
+	if gs.Variant != DriftVariant || err != nil || turn.Position == 0 {
+		return gs.RandomNumber
+	}
+	return turn.Position
+}
+
+// Secret returns the secret of the round, which its fairness commitment is
+// made to: the random number, with the seed drawing its moves after a slash
+// in the drift variant, such as "42/7", or every hidden number joined by
+// commas in the multi variant.
+func (gs *GameState) Secret() string {
+	switch {
+	case gs.Variant == MultiVariant && len(gs.Secrets) > 0:
+		secrets := make([]string, len(gs.Secrets))
+		for i, secret := range gs.Secrets {
+			secrets[i] = strconv.Itoa(secret)
+		}
+		return strings.Join(secrets, ",")
+	case gs.Variant == DriftVariant:
+		return fmt.Sprintf("%d/%d", gs.RandomNumber, gs.Seed)
+	default:
+		return strconv.Itoa(gs.RandomNumber)
+	}
+}
+
+// Target returns the number hints are about: the secret not found yet
+// nearest to the last guess in the multi variant, and the position of the
+// random number otherwise.
+func (gs *GameState) Target() int {
+	unfound := gs.Unfound()
+	if gs.Variant != MultiVariant || len(unfound) == 0 {
+		return gs.Position()
+	}
+
+	turn, err := gs.GetLastTurn()
+	if err != nil {
+		return unfound[0]
+	}
+	return nearest(unfound, turn.GuessNumber)
+}
+
+// CodeDigits returns the number of digits of the code in the code variant.
+func (gs *GameState) CodeDigits() int {
+	_, high := gs.Bounds()
+	return len(strconv.Itoa(high))
 }
 
 // PlayTurn processes a player's turn, validating the game state and updating
@@ -123,6 +439,10 @@ func (gs *GameState) PlayTurn(turn Turn) error {
 	gs.newDifference(&turn)
 	gs.compareNumbers(turn)
 	gs.getDifference(turn)
+	gs.scoreCode(&turn)
+	gs.scoreSecrets(&turn)
+	gs.drift(&turn)
+	gs.tellLie(&turn)
 	gs.appendTurn(turn)
 
 	if err := gs.validateMaxLengthTurn(); err != nil {
@@ -132,9 +452,40 @@ func (gs *GameState) PlayTurn(turn Turn) error {
 	return nil
 }
 
-// GetAttempts returns the number of attempts made in the game.
+// UseHint spends an attempt on an extra clue without playing a turn,
+// returning an error if the number is found or no attempts are left.
+func (gs *GameState) UseHint() error {
+	if err := gs.validateRandomNumberNotFound(); err != nil {
+		return err
+	}
+
+	if gs.NoMoreAttempts() {
+		return NewNoMoreAttemptsError()
+	}
+
+	gs.HintsUsed++
+	return nil
+}
+
+// Expire wastes an attempt whose guess wasn't entered in time, returning an
+// error if the number is found or no attempts are left.
+func (gs *GameState) Expire() error {
+	if err := gs.validateRandomNumberNotFound(); err != nil {
+		return err
+	}
+
+	if gs.NoMoreAttempts() {
+		return NewNoMoreAttemptsError()
+	}
+
+	gs.Expired++
+	return nil
+}
+
+// GetAttempts returns the number of attempts made in the game, including
+// the ones spent on hints and the expired ones.
 func (gs *GameState) GetAttempts() int {
-	return len(gs.Turns)
+	return len(gs.Turns) + gs.HintsUsed + gs.Expired
 }
 
 // GetLastTurn retrieves the last turn made in the game, returning an error
@@ -148,10 +499,159 @@ func (gs *GameState) GetLastTurn() (Turn, error) {
 
 // NoMoreAttempts checks if the maximum number of attempts has been reached.
 func (gs *GameState) NoMoreAttempts() bool {
-	return gs.GetAttempts() == gs.MaxAttempts
+	return gs.GetAttempts() >= gs.MaxAttempts
+}
+
+// Lies returns the positions, from 1, of the turns whose outcome was a lie,
+// or nil when none was.
+func (gs *GameState) Lies() []int {
+	var lies []int
+	for i, turn := range gs.Turns {
+		if turn.Lied {
+			lies = append(lies, i+1)
+		}
+	}
+	return lies
+}
+
+// LiesLeft returns the number of outcomes that may still be lies.
+func (gs *GameState) LiesLeft() int {
+	return max(0, gs.MaxLies-len(gs.Lies()))
+}
+
+// Contradictions counts the outcomes of the turns played so far that the
+// number contradicts, which must all be lies for it to be the random number.
+func (gs *GameState) Contradictions(number int) int {
+	var contradictions int
+
+	for _, turn := range gs.Turns {
+		if turn.Outcome == nil {
+			continue
+		}
+
+		switch *turn.Outcome {
+		case Greater:
+			if number <= turn.GuessNumber {
+				contradictions++
+			}
+		case Less:
+			if number >= turn.GuessNumber {
+				contradictions++
+			}
+		case Equal:
+			if number != turn.GuessNumber {
+				contradictions++
+			}
+		}
+	}
+
+	return contradictions
+}
+
+// PossibleRange returns the interval still containing the random number,
+// narrowed by the outcomes of the turns played so far. When outcomes may be
+// lies, it spans the numbers contradicting at most MaxLies of them.
+func (gs *GameState) PossibleRange() (int, int) {
+	low, high := gs.Bounds()
+
+	// The directions of the nearest secrets don't bound the others.
+	if gs.Variant == MultiVariant {
+		return low, high
+	}
+
+	if gs.Variant == DriftVariant {
+		return gs.possibleRangeWithDrift(low, high)
+	}
+
+	if gs.MaxLies > 0 {
+		return gs.possibleRangeWithLies(low, high)
+	}
+
+	for _, turn := range gs.Turns {
+		if turn.Outcome == nil {
+			continue
+		}
+
+		switch *turn.Outcome {
+		case Greater:
+			low = max(low, turn.GuessNumber+1)
+		case Less:
+			high = min(high, turn.GuessNumber-1)
+		case Equal:
+			low, high = turn.GuessNumber, turn.GuessNumber
+		}
+	}
+
+	return low, high
+}
+
+// RedundantTurns counts the guesses that couldn't be the random number given
+// the outcomes of the turns before them, such as a guess outside the
+// possible range or a repeated guess.
+func (gs *GameState) RedundantTurns() int {
+	var redundant int
+
+	for i, turn := range gs.Turns {
+		previous := GameState{
+			Turns:   gs.Turns[:i],
+			Min:     gs.Min,
+			Max:     gs.Max,
+			MaxLies: gs.MaxLies,
+			Variant: gs.Variant,
+		}
+		low, high := previous.PossibleRange()
+		if turn.GuessNumber < low || turn.GuessNumber > high {
+			redundant++
+		}
+	}
+
+	return redundant
+}
+
+// possibleRangeWithDrift widens the range by the step the number may move
+// before narrowing it with each answer, which is about where it moved.
+func (gs *GameState) possibleRangeWithDrift(low, high int) (int, int) {
+	first, last := low, high
+	for _, turn := range gs.Turns {
+		if turn.Outcome == nil {
+			continue
+		}
+
+		first, last = max(low, first-DriftStep), min(high, last+DriftStep)
+		switch *turn.Outcome {
+		case Greater:
+			first = max(first, turn.GuessNumber+1)
+		case Less:
+			last = min(last, turn.GuessNumber-1)
+		case Equal:
+			first, last = turn.GuessNumber, turn.GuessNumber
+		}
+	}
+
+	return first, last
+}
+
+func (gs *GameState) possibleRangeWithLies(low, high int) (int, int) {
+	first, last := high+1, low-1
+	for number := low; number <= high; number++ {
+		if gs.Contradictions(number) > gs.MaxLies {
+			continue
+		}
+		first = min(first, number)
+		last = max(last, number)
+	}
+
+	return first, last
 }
 
 func (gs *GameState) validateLevelAndMaxAttempts() error {
+	if gs.Level == AdaptiveLevel {
+		if gs.MaxAttempts < 1 {
+			return NewMaxAttemptsError()
+		}
+		return nil
+	}
+
 	levelMaxAttempts := map[string]int{
 		"Easy":   10,
 		"Medium": 5,
@@ -173,7 +673,7 @@ func (gs *GameState) validateLevelAndMaxAttempts() error {
 func (gs *GameState) validateRandomNumberNotFound() error {
 	if len(gs.Turns) > 0 {
 		lastTurn := gs.Turns[len(gs.Turns)-1]
-		if *lastTurn.Outcome == 0 {
+		if *lastTurn.Outcome == Equal {
 			return NewRandomNumberFoundError()
 		}
 	}
@@ -181,14 +681,14 @@ func (gs *GameState) validateRandomNumberNotFound() error {
 }
 
 func (gs *GameState) validateMaxLengthTurn() error {
-	if len(gs.Turns) > gs.MaxAttempts {
+	if gs.GetAttempts() > gs.MaxAttempts {
 		return NewTurnsLengthError(gs.Turns, gs.MaxAttempts)
 	}
 	return nil
 }
 
 func (*GameState) newOutcome(turn *Turn) {
-	turn.Outcome = new(int)
+	turn.Outcome = new(Outcome)
 }
 
 func (gs *GameState) newDifference(turn *Turn) {
@@ -201,16 +701,142 @@ func (gs *GameState) appendTurn(turn Turn) {
 
 func (gs *GameState) compareNumbers(turn Turn) {
 	switch {
-	case turn.GuessNumber > gs.RandomNumber:
-		*turn.Outcome = -1
-	case turn.GuessNumber < gs.RandomNumber:
-		*turn.Outcome = 1
+	case gs.Variant == CodeVariant && turn.GuessNumber != gs.RandomNumber:
+		*turn.Outcome = Mismatch
+	case turn.GuessNumber > gs.Position():
+		*turn.Outcome = Less
+	case turn.GuessNumber < gs.Position():
+		*turn.Outcome = Greater
 	default:
-		*turn.Outcome = 0
+		*turn.Outcome = Equal
+	}
+}
+
+// tellLie reverses the outcome of the turn when a lie is asked for and the
+// state may still lie. Only greater or less outcomes are lied about.
+func (gs *GameState) tellLie(turn *Turn) {
+	greaterOrLess := *turn.Outcome == Greater || *turn.Outcome == Less
+	if !turn.TellLie || !greaterOrLess || gs.LiesLeft() == 0 {
+		return
 	}
+
+	*turn.Outcome = -*turn.Outcome
+	turn.Lied = true
 }
 
 func (gs *GameState) getDifference(turn Turn) {
-	difference := int(math.Abs(float64(gs.RandomNumber - turn.GuessNumber)))
+	difference := int(math.Abs(float64(gs.Position() - turn.GuessNumber)))
 	*turn.Difference = difference
 }
+
+// scoreCode counts the bulls and cows of the guess in the code variant, a
+// digit of the code matching at most one digit of the guess.
+func (gs *GameState) scoreCode(turn *Turn) {
+	if gs.Variant != CodeVariant {
+		return
+	}
+
+	code := strconv.Itoa(gs.RandomNumber)
+	guess := strconv.Itoa(turn.GuessNumber)
+
+	var codeDigits, guessDigits [10]int
+	for i := range min(len(code), len(guess)) {
+		if code[i] == guess[i] {
+			turn.Bulls++
+			continue
+		}
+		codeDigits[code[i]-'0']++
+		guessDigits[guess[i]-'0']++
+	}
+	for digit := range codeDigits {
+		turn.Cows += min(codeDigits[digit], guessDigits[digit])
+	}
+
+	*turn.Difference = len(code) - turn.Bulls
+}
+
+// scoreSecrets tells the outcome of the guess in the multi variant: equal
+// once every secret is found, a hit when it finds one of the others, and
+// the direction and difference of the nearest secret left otherwise.
+func (gs *GameState) scoreSecrets(turn *Turn) {
+	if gs.Variant != MultiVariant {
+		return
+	}
+
+	var unfound []int
+	var hit bool
+	for _, secret := range gs.Unfound() {
+		if secret == turn.GuessNumber {
+			hit = true
+			continue
+		}
+		unfound = append(unfound, secret)
+
+		if secret > turn.GuessNumber {
+			turn.Above++
+		} else {
+			turn.Below++
+		}
+	}
+
+	switch {
+	case len(unfound) == 0:
+		*turn.Outcome = Equal
+		*turn.Difference = 0
+	case hit:
+		*turn.Outcome = Hit
+		*turn.Difference = 0
+	default:
+		target := nearest(unfound, turn.GuessNumber)
+		*turn.Outcome = Greater
+		if target < turn.GuessNumber {
+			*turn.Outcome = Less
+		}
+		*turn.Difference = abs(target - turn.GuessNumber)
+	}
+}
+
+// drift moves the number after a wrong guess in the drift variant, by a step
+// of at most DriftStep drawn from the seed and the turn, staying in the range
+// and off the guess. The outcome and difference then tell where it moved.
+func (gs *GameState) drift(turn *Turn) {
+	if gs.Variant != DriftVariant {
+		return
+	}
+
+	if *turn.Outcome == Equal {
+		turn.Position = turn.GuessNumber
+		return
+	}
+
+	random := rand.New(rand.NewPCG(gs.Seed, uint64(len(gs.Turns))))
+	step := random.IntN(2*DriftStep+1) - DriftStep
+
+	low, high := gs.Bounds()
+	position := gs.Position()
+	if moved := min(high, max(low, position+step)); moved != turn.GuessNumber {
+		position = moved
+	}
+
+	turn.Position = position
+	*turn.Outcome = Greater
+	if position < turn.GuessNumber {
+		*turn.Outcome = Less
+	}
+	*turn.Difference = abs(position - turn.GuessNumber)
+}
+
+// nearest returns the number nearest to the guess, the first one on a tie.
+func nearest(numbers []int, guess int) int {
+	best := numbers[0]
+	for _, number := range numbers[1:] {
+		if abs(number-guess) < abs(best-guess) {
+			best = number
+		}
+	}
+	return best
+}
+
+func abs(number int) int {
+	return int(math.Abs(float64(number)))
+}
@@ -38,17 +38,17 @@ func TestUnitPlayTurn(t *testing.T) {
 				afterTurns: game.Turns{
 					{
 						GuessNumber: 25,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(25),
 					},
 					{
 						GuessNumber: 75,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(25),
 					},
 					{
 						GuessNumber: 50,
-						Outcome:     toPointer(0),
+						Outcome:     toOutcome(game.Equal),
 						Difference:  toPointer(0),
 					},
 				},
@@ -67,27 +67,27 @@ func TestUnitPlayTurn(t *testing.T) {
 				afterTurns: game.Turns{
 					{
 						GuessNumber: 30,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(20),
 					},
 					{
 						GuessNumber: 70,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(20),
 					},
 					{
 						GuessNumber: 40,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(10),
 					},
 					{
 						GuessNumber: 60,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(10),
 					},
 					{
 						GuessNumber: 50,
-						Outcome:     toPointer(0),
+						Outcome:     toOutcome(game.Equal),
 						Difference:  toPointer(0),
 					},
 				},
@@ -111,52 +111,52 @@ func TestUnitPlayTurn(t *testing.T) {
 				afterTurns: game.Turns{
 					{
 						GuessNumber: 25,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(25),
 					},
 					{
 						GuessNumber: 70,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(20),
 					},
 					{
 						GuessNumber: 30,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(20),
 					},
 					{
 						GuessNumber: 65,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(15),
 					},
 					{
 						GuessNumber: 35,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(15),
 					},
 					{
 						GuessNumber: 60,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(10),
 					},
 					{
 						GuessNumber: 40,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(10),
 					},
 					{
 						GuessNumber: 55,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(5),
 					},
 					{
 						GuessNumber: 45,
-						Outcome:     toPointer(1),
+						Outcome:     toOutcome(game.Greater),
 						Difference:  toPointer(5),
 					},
 					{
 						GuessNumber: 50,
-						Outcome:     toPointer(0),
+						Outcome:     toOutcome(game.Equal),
 						Difference:  toPointer(0),
 					},
 				},
@@ -306,6 +306,11 @@ func TestUnitPlayTurn(t *testing.T) {
 				level:       "Hard",
 				maxAttempts: 10,
 			},
+			{
+				description: "no attempts for adaptive",
+				level:       game.AdaptiveLevel,
+				maxAttempts: 0,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -351,7 +356,7 @@ func TestUnitGetAttempts(t *testing.T) {
 		RandomNumber: 50,
 		Turns: game.Turns{{
 			GuessNumber: 50,
-			Outcome:     toPointer(0),
+			Outcome:     toOutcome(game.Equal),
 			Difference:  toPointer(0),
 		}},
 	}
@@ -368,7 +373,7 @@ func TestUnitGetLastTurn(t *testing.T) {
 			RandomNumber: 50,
 			Turns: game.Turns{{
 				GuessNumber: 50,
-				Outcome:     toPointer(0),
+				Outcome:     toOutcome(game.Equal),
 				Difference:  toPointer(0),
 			}},
 		}
@@ -378,7 +383,7 @@ func TestUnitGetLastTurn(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, game.Turn{
 			GuessNumber: 50,
-			Outcome:     toPointer(0),
+			Outcome:     toOutcome(game.Equal),
 			Difference:  toPointer(0),
 		}, got)
 	})
@@ -411,17 +416,17 @@ func TestUnitNoMoreAttempts(t *testing.T) {
 				turns: game.Turns{
 					{
 						GuessNumber: 53,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(3),
 					},
 					{
 						GuessNumber: 52,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(2),
 					},
 					{
 						GuessNumber: 51,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(1),
 					},
 				},
@@ -432,12 +437,12 @@ func TestUnitNoMoreAttempts(t *testing.T) {
 				turns: game.Turns{
 					{
 						GuessNumber: 53,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(3),
 					},
 					{
 						GuessNumber: 52,
-						Outcome:     toPointer(-1),
+						Outcome:     toOutcome(game.Less),
 						Difference:  toPointer(2),
 					},
 				},
@@ -458,6 +463,630 @@ func TestUnitNoMoreAttempts(t *testing.T) {
 	})
 }
 
+func TestUnitOptimalGuesses(t *testing.T) {
+	t.Run("return the worst case of a binary search", func(t *testing.T) {
+		testCases := []struct {
+			low, high int
+			want      int
+		}{
+			{low: 1, high: 100, want: 7},
+			{low: 1, high: 127, want: 7},
+			{low: 1, high: 128, want: 8},
+			{low: 5, high: 5, want: 1},
+			{low: 6, high: 5, want: 0},
+		}
+
+		for _, tc := range testCases {
+			assert.Equal(t, tc.want, game.OptimalGuesses(tc.low, tc.high))
+		}
+	})
+}
+
+func TestUnitCodeVariant(t *testing.T) {
+	t.Run("count the bulls and cows of the guess", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			code        int
+			guess       int
+			wantBulls   int
+			wantCows    int
+		}{
+			{description: "swapped digits", code: 1234, guess: 1243, wantBulls: 2, wantCows: 2},
+			{description: "no common digit", code: 1234, guess: 5678, wantBulls: 0, wantCows: 0},
+			{description: "repeated digits", code: 1122, guess: 2211, wantBulls: 0, wantCows: 4},
+			{description: "repeated guess digit", code: 1123, guess: 1111, wantBulls: 2, wantCows: 0},
+			{description: "digit matched once", code: 1234, guess: 5115, wantBulls: 0, wantCows: 1},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: tc.code,
+					Variant:      game.CodeVariant,
+					Min:          1000,
+					Max:          9999,
+				}
+
+				assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: tc.guess}))
+
+				turn, _ := gameState.GetLastTurn()
+				assert.Equal(t, game.Mismatch, *turn.Outcome)
+				assert.Equal(t, tc.wantBulls, turn.Bulls)
+				assert.Equal(t, tc.wantCows, turn.Cows)
+				assert.Equal(t, 4-tc.wantBulls, *turn.Difference)
+			})
+		}
+	})
+
+	t.Run("find the code", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 1234,
+			Variant:      game.CodeVariant,
+			Min:          1000,
+			Max:          9999,
+		}
+
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 4321, TellLie: true}))
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 1234}))
+
+		turn, _ := gameState.GetLastTurn()
+		assert.Equal(t, game.Equal, *turn.Outcome)
+		assert.Equal(t, 4, turn.Bulls)
+		assert.Equal(t, 4, gameState.CodeDigits())
+		assert.Nil(t, gameState.Lies())
+
+		low, high := gameState.PossibleRange()
+		assert.Equal(t, 1234, low)
+		assert.Equal(t, 1234, high)
+	})
+
+	t.Run("return the bounds of the codes", func(t *testing.T) {
+		low, high := game.CodeBounds(4)
+		assert.Equal(t, 1000, low)
+		assert.Equal(t, 9999, high)
+	})
+}
+
+func TestUnitMultiVariant(t *testing.T) {
+	newGameState := func() game.GameState {
+		return game.GameState{
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 20,
+			Variant:      game.MultiVariant,
+			Secrets:      []int{20, 60, 75},
+		}
+	}
+
+	t.Run("tell the nearest hidden number and the numbers on each side", func(t *testing.T) {
+		testCases := []struct {
+			description    string
+			guesses        []int
+			wantOutcome    game.Outcome
+			wantDifference int
+			wantAbove      int
+			wantBelow      int
+		}{
+			{
+				description:    "nearest greater",
+				guesses:        []int{50},
+				wantOutcome:    game.Greater,
+				wantDifference: 10,
+				wantAbove:      2,
+				wantBelow:      1,
+			},
+			{
+				description:    "nearest less",
+				guesses:        []int{30},
+				wantOutcome:    game.Less,
+				wantDifference: 10,
+				wantAbove:      2,
+				wantBelow:      1,
+			},
+			{
+				description: "hit",
+				guesses:     []int{60},
+				wantOutcome: game.Hit,
+				wantAbove:   1,
+				wantBelow:   1,
+			},
+			{
+				description:    "found numbers left out",
+				guesses:        []int{20, 25},
+				wantOutcome:    game.Greater,
+				wantDifference: 35,
+				wantAbove:      2,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := newGameState()
+				for _, guess := range tc.guesses {
+					assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+				}
+
+				turn, _ := gameState.GetLastTurn()
+				assert.Equal(t, tc.wantOutcome, *turn.Outcome)
+				assert.Equal(t, tc.wantDifference, *turn.Difference)
+				assert.Equal(t, tc.wantAbove, turn.Above)
+				assert.Equal(t, tc.wantBelow, turn.Below)
+			})
+		}
+	})
+
+	t.Run("win once every hidden number is found", func(t *testing.T) {
+		gameState := newGameState()
+
+		for _, guess := range []int{60, 20, 60} {
+			assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+		}
+		assert.Equal(t, []int{75}, gameState.Unfound())
+		assert.Equal(t, 75, gameState.Target())
+
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 75}))
+
+		turn, _ := gameState.GetLastTurn()
+		assert.Equal(t, game.Equal, *turn.Outcome)
+		assert.Nil(t, gameState.Unfound())
+
+		want := game.NewRandomNumberFoundError()
+		got := gameState.PlayTurn(game.Turn{GuessNumber: 10})
+		assert.ErrorAs(t, got, &want)
+	})
+
+	t.Run("keep the whole range possible", func(t *testing.T) {
+		gameState := newGameState()
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 50}))
+
+		low, high := gameState.PossibleRange()
+		assert.Equal(t, 1, low)
+		assert.Equal(t, 100, high)
+	})
+
+	t.Run("draw distinct hidden numbers", func(t *testing.T) {
+		got, err := game.NewSecrets(5, 42, 1, 5)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, got)
+
+		got, err = game.NewSecrets(3, 42, 1, 100)
+
+		assert.NoError(t, err)
+		assert.Len(t, got, 3)
+		assert.Equal(t, 42, got[0])
+	})
+
+	t.Run("error when the hidden numbers can't be drawn", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			count       int
+			max         int
+		}{
+			{description: "too few", count: 1, max: 100},
+			{description: "too many", count: 6, max: 100},
+			{description: "range too small", count: 3, max: 2},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				want := game.NewSecretsError()
+				_, got := game.NewSecrets(tc.count, 1, 1, tc.max)
+
+				assert.NotNil(t, got)
+				assert.ErrorAs(t, got, &want)
+			})
+		}
+	})
+}
+
+func TestUnitParseVariant(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			want string
+		}{
+			{name: "number", want: ""},
+			{name: "code", want: game.CodeVariant},
+			{name: "multi", want: game.MultiVariant},
+			{name: "drift", want: game.DriftVariant},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got, err := game.ParseVariant(tc.name)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("error listing the variants when unknown", func(t *testing.T) {
+		_, got := game.ParseVariant("chess")
+
+		assert.Equal(t, game.NewVariantError("chess"), got)
+		assert.EqualError(t, got,
+			`No variant is named "chess": choose number, code, multi or drift.`,
+		)
+	})
+}
+
+func TestUnitDriftVariant(t *testing.T) {
+	newGameState := func(seed uint64) game.GameState {
+		return game.GameState{
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			Variant:      game.DriftVariant,
+			Seed:         seed,
+		}
+	}
+	guesses := []int{10, 90, 20, 80, 30, 70}
+
+	t.Run("move the number by a bounded step and tell where it moved", func(t *testing.T) {
+		gameState := newGameState(7)
+
+		previous := 50
+		for _, guess := range guesses {
+			assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+
+			turn, _ := gameState.GetLastTurn()
+			position := gameState.Position()
+			assert.Equal(t, turn.Position, position)
+			assert.LessOrEqual(t, max(position-previous, previous-position), game.DriftStep)
+			assert.NotEqual(t, guess, position)
+			assert.Equal(t, max(position-guess, guess-position), *turn.Difference)
+			if position > guess {
+				assert.Equal(t, game.Greater, *turn.Outcome)
+			} else {
+				assert.Equal(t, game.Less, *turn.Outcome)
+			}
+
+			low, high := gameState.PossibleRange()
+			assert.GreaterOrEqual(t, position, low)
+			assert.LessOrEqual(t, position, high)
+			previous = position
+		}
+	})
+
+	t.Run("move the same with the same seed", func(t *testing.T) {
+		first, second := newGameState(7), newGameState(7)
+		for _, guess := range guesses {
+			assert.NoError(t, first.PlayTurn(game.Turn{GuessNumber: guess}))
+			assert.NoError(t, second.PlayTurn(game.Turn{GuessNumber: guess}))
+		}
+
+		assert.Equal(t, first.Turns, second.Turns)
+	})
+
+	t.Run("win on the number where it stands", func(t *testing.T) {
+		gameState := newGameState(7)
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 10}))
+		position := gameState.Position()
+
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: position}))
+
+		turn, _ := gameState.GetLastTurn()
+		assert.Equal(t, game.Equal, *turn.Outcome)
+		assert.Equal(t, position, gameState.Position())
+	})
+
+	t.Run("stay still in the other variants", func(t *testing.T) {
+		gameState := newGameState(7)
+		gameState.Variant = ""
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 10}))
+
+		turn, _ := gameState.GetLastTurn()
+		assert.Zero(t, turn.Position)
+		assert.Equal(t, 50, gameState.Position())
+	})
+}
+
+func TestUnitRedundantTurns(t *testing.T) {
+	t.Run("count guesses outside the possible range", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			guesses     []int
+			want        int
+		}{
+			{description: "binary search", guesses: []int{50, 25, 37}, want: 0},
+			{description: "outside range", guesses: []int{50, 60, 70}, want: 2},
+			{description: "repeated guess", guesses: []int{50, 50}, want: 1},
+			{description: "no turns", guesses: nil, want: 0},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: 30,
+					Turns:        game.Turns{},
+				}
+				for _, guess := range tc.guesses {
+					_ = gameState.PlayTurn(game.Turn{GuessNumber: guess})
+				}
+
+				assert.Equal(t, tc.want, gameState.RedundantTurns())
+			})
+		}
+	})
+}
+
+func TestUnitExpire(t *testing.T) {
+	t.Run("waste an attempt without playing a turn", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			Turns:        game.Turns{},
+			HintsUsed:    1,
+		}
+
+		err := gameState.Expire()
+
+		assert.NoError(t, err)
+		assert.Empty(t, gameState.Turns)
+		assert.Equal(t, 2, gameState.GetAttempts())
+	})
+
+	t.Run("error when no more attempts", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			Expired:      3,
+		}
+
+		want := game.NewNoMoreAttemptsError()
+		got := gameState.Expire()
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+}
+
+func TestUnitUseHint(t *testing.T) {
+	t.Run("spend an attempt without playing a turn", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			Turns:        game.Turns{},
+		}
+
+		err := gameState.UseHint()
+
+		assert.NoError(t, err)
+		assert.Empty(t, gameState.Turns)
+		assert.Equal(t, 1, gameState.GetAttempts())
+	})
+
+	t.Run("error when no more attempts", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			HintsUsed:    3,
+		}
+
+		want := game.NewNoMoreAttemptsError()
+		got := gameState.UseHint()
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+
+	t.Run("error when random number found", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			Turns: game.Turns{
+				{
+					GuessNumber: 50,
+					Outcome:     toOutcome(game.Equal),
+					Difference:  toPointer(0),
+				},
+			},
+		}
+
+		want := game.NewRandomNumberFoundError()
+		got := gameState.UseHint()
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+}
+
+func TestUnitPossibleRange(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			turns       game.Turns
+			wantLow     int
+			wantHigh    int
+		}{
+			{
+				description: "full range when no turns",
+				turns:       game.Turns{},
+				wantLow:     1,
+				wantHigh:    100,
+			},
+			{
+				description: "narrowed range after greater and less",
+				turns: game.Turns{
+					{
+						GuessNumber: 25,
+						Outcome:     toOutcome(game.Greater),
+						Difference:  toPointer(25),
+					},
+					{
+						GuessNumber: 75,
+						Outcome:     toOutcome(game.Less),
+						Difference:  toPointer(25),
+					},
+				},
+				wantLow:  26,
+				wantHigh: 74,
+			},
+			{
+				description: "single number when found",
+				turns: game.Turns{
+					{
+						GuessNumber: 50,
+						Outcome:     toOutcome(game.Equal),
+						Difference:  toPointer(0),
+					},
+				},
+				wantLow:  50,
+				wantHigh: 50,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: 50,
+					Turns:        tc.turns,
+				}
+
+				low, high := gameState.PossibleRange()
+				assert.Equal(t, tc.wantLow, low)
+				assert.Equal(t, tc.wantHigh, high)
+			})
+		}
+	})
+
+	t.Run("start from the range of the round", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        game.AdaptiveLevel,
+			MaxAttempts:  6,
+			RandomNumber: 150,
+			Min:          1,
+			Max:          200,
+		}
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 120}))
+
+		low, high := gameState.PossibleRange()
+		assert.Equal(t, 121, low)
+		assert.Equal(t, 200, high)
+	})
+}
+
+func TestUnitLies(t *testing.T) {
+	t.Run("reverse the outcomes asked to be lies while allowed", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			MaxLies:      1,
+		}
+
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 40, TellLie: true}))
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 60, TellLie: true}))
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 50, TellLie: true}))
+
+		assert.Equal(t, game.Less, *gameState.Turns[0].Outcome)
+		assert.Equal(t, game.Less, *gameState.Turns[1].Outcome)
+		assert.Equal(t, game.Equal, *gameState.Turns[2].Outcome)
+		assert.True(t, gameState.Turns[0].Lied)
+		assert.True(t, gameState.Turns[1].TellLie)
+		assert.False(t, gameState.Turns[1].Lied)
+		assert.Equal(t, []int{1}, gameState.Lies())
+		assert.Equal(t, 0, gameState.LiesLeft())
+	})
+
+	t.Run("never lie without lies allowed", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+		}
+
+		assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 40, TellLie: true}))
+
+		assert.Equal(t, game.Greater, *gameState.Turns[0].Outcome)
+		assert.Nil(t, gameState.Lies())
+	})
+
+	t.Run("keep the numbers needing at most the lies allowed", func(t *testing.T) {
+		gameState := game.GameState{
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			MaxLies:      1,
+		}
+		for _, guess := range []int{40, 45, 70} {
+			assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+		}
+
+		assert.Equal(t, 0, gameState.Contradictions(50))
+		assert.Equal(t, 1, gameState.Contradictions(42))
+		assert.Equal(t, 2, gameState.Contradictions(30))
+
+		low, high := gameState.PossibleRange()
+		assert.Equal(t, 41, low)
+		assert.Equal(t, 100, high)
+	})
+}
+
+func TestUnitSecret(t *testing.T) {
+	testCases := []struct {
+		name      string
+		gameState game.GameState
+		want      string
+	}{
+		{
+			name:      "return the random number",
+			gameState: game.GameState{RandomNumber: 42},
+			want:      "42",
+		},
+		{
+			name: "return the hidden numbers of the multi variant",
+			gameState: game.GameState{
+				RandomNumber: 42,
+				Variant:      game.MultiVariant,
+				Secrets:      []int{42, 7, 91},
+			},
+			want: "42,7,91",
+		},
+		{
+			name: "return the number and the seed of the drift variant",
+			gameState: game.GameState{
+				RandomNumber: 42,
+				Variant:      game.DriftVariant,
+				Seed:         7,
+			},
+			want: "42/7",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.gameState.Secret())
+		})
+	}
+}
+
+func TestUnitOutcomeString(t *testing.T) {
+	t.Run("return outcome name", func(t *testing.T) {
+		assert.Equal(t, "greater", game.Greater.String())
+		assert.Equal(t, "less", game.Less.String())
+		assert.Equal(t, "equal", game.Equal.String())
+	})
+}
+
 func toPointer(value int) *int {
 	return &value
 }
+
+func toOutcome(value game.Outcome) *game.Outcome {
+	return &value
+}
@@ -0,0 +1,250 @@
+// Package hint provides the strategies giving a hint after every wrong
+// guess, such as how far the guess is from the number or whether the player
+// is getting warmer. The strategy of each level is set in the config.
+package hint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-number-guessing-game/internal/game"
+)
+
+// StrategyError represents an error occurring when the config names an
+// unknown hint strategy.
+type StrategyError struct {
+	Level    string
+	Strategy string
+}
+
+// StrategyMessage is the message displayed when a strategy is unknown. It is
+// public for testing purposes.
+const StrategyMessage = "Unknown hint strategy %q for level %q."
+
+// Error returns the error message for StrategyError.
+func (e *StrategyError) Error() string {
+	return fmt.Sprintf(StrategyMessage, e.Strategy, e.Level)
+}
+
+// NewStrategyError creates a new StrategyError for testing.
+func NewStrategyError(level, strategy string) error {
+	return &StrategyError{Level: level, Strategy: strategy}
+}
+
+// Hint is the message shown after a wrong guess: the key of the message in
+// the game config, formatted with the arguments. An empty key gives no hint.
+type Hint struct {
+	Key  string
+	Args []any
+}
+
+// Strategy gives the hint of the last turn of the game state, which is a
+// wrong guess.
+type Strategy interface {
+	Hint(gameState game.GameState) Hint
+}
+
+// Names of the strategies in the config.
+const (
+	NameDistance     = "distance"
+	NameProportional = "proportional"
+	NameHotCold      = "hot_cold"
+	NameDivisibility = "divisibility"
+	NameDigitSum     = "digit_sum"
+	NameNone         = "none"
+)
+
+// Strategies maps the lower-cased levels to their strategy.
+type Strategies map[string]Strategy
+
+// NewStrategies reads the strategy of each level from the flattened config,
+// such as {"easy": "distance", "hard": "hot_cold"}. It returns a
+// StrategyError when a strategy is unknown.
+func NewStrategies(config map[string]string) (Strategies, error) {
+	strategies := Strategies{}
+	for level, name := range config {
+		strategy, ok := New(name)
+		if !ok {
+			return nil, NewStrategyError(level, name)
+		}
+		strategies[strings.ToLower(level)] = strategy
+	}
+
+	return strategies, nil
+}
+
+// New returns the strategy with the name, and whether it exists.
+func New(name string) (Strategy, bool) {
+	switch name {
+	case NameDistance:
+		return Distance{}, true
+	case NameProportional:
+		return Proportional{}, true
+	case NameHotCold:
+		return HotCold{}, true
+	case NameDivisibility:
+		return Divisibility{}, true
+	case NameDigitSum:
+		return DigitSum{}, true
+	case NameNone:
+		return None{}, true
+	default:
+		return nil, false
+	}
+}
+
+// For returns the strategy of the level, defaulting to Distance.
+func (s Strategies) For(level string) Strategy {
+	if strategy, ok := s[strings.ToLower(level)]; ok {
+		return strategy
+	}
+	return Distance{}
+}
+
+// Distance hints how far the guess is from the number, in fixed buckets
+// meant for the range from 1 to 100.
+type Distance struct{}
+
+// Hint returns the distance bucket of the last guess.
+func (Distance) Hint(gameState game.GameState) Hint {
+	turn, _ := gameState.GetLastTurn()
+	return Hint{Key: DistanceKey(difference(turn))}
+}
+
+// DistanceKey returns the key of the hint message for the distance between
+// a guess and the number.
+func DistanceKey(difference int) string {
+	switch {
+	case difference == 1:
+		return "very_close_1"
+	case difference == 2:
+		return "very_close_2"
+	case difference == 3:
+		return "very_close_3"
+	case difference == 4:
+		return "close_1"
+	case difference == 5:
+		return "close_2"
+	case difference > 5 && difference < 10:
+		return "far"
+	default:
+		return "very_far"
+	}
+}
+
+// Proportional hints how far the guess is from the number relative to the
+// size of the range, giving the Distance buckets on a range of 100 and
+// scaling them on other ranges.
+type Proportional struct{}
+
+// Hint returns the distance bucket of the last guess, scaled to the range.
+func (Proportional) Hint(gameState game.GameState) Hint {
+	turn, _ := gameState.GetLastTurn()
+	low, high := gameState.Bounds()
+
+	// Scale the difference to a range of 100, rounding up so that only
+	// guesses next to the number are extremely close.
+	size := high - low + 1
+	scaled := (difference(turn)*100 + size - 1) / size
+	return Hint{Key: DistanceKey(max(1, scaled))}
+}
+
+// HotCold hints whether the guess is closer to the number than the previous
+// one.
+type HotCold struct{}
+
+// Hint compares the distance of the last guess with the previous one.
+func (HotCold) Hint(gameState game.GameState) Hint {
+	turns := gameState.Turns
+	if len(turns) < 2 {
+		return Hint{Key: "hot_first"}
+	}
+
+	current := difference(turns[len(turns)-1])
+	previous := difference(turns[len(turns)-2])
+	switch {
+	case current < previous:
+		return Hint{Key: "warmer"}
+	case current > previous:
+		return Hint{Key: "colder"}
+	default:
+		return Hint{Key: "as_warm"}
+	}
+}
+
+// Divisors lists the divisors of the divisibility hints, given in turn after
+// every wrong guess, and of the clues bought with attempts, starting with
+// the parity of the number.
+var Divisors = []int{2, 3, 5, 7}
+
+// Divisibility hints whether the number is a multiple of the next divisor,
+// cycling through Divisors.
+type Divisibility struct{}
+
+// Hint tells whether the number is a multiple of the divisor of the turn.
+func (Divisibility) Hint(gameState game.GameState) Hint {
+	divisor := Divisors[(len(gameState.Turns)-1)%len(Divisors)]
+	return Multiple(divisor, gameState.Target()%divisor == 0)
+}
+
+// Multiple returns the hint telling whether the number is a multiple of the
+// divisor, told as its parity for 2.
+func Multiple(divisor int, multiple bool) Hint {
+	switch {
+	case divisor == 2 && multiple:
+		return Hint{Key: "hint_even"}
+	case divisor == 2:
+		return Hint{Key: "hint_odd"}
+	case multiple:
+		return Hint{Key: "hint_multiple", Args: []any{divisor}}
+	default:
+		return Hint{Key: "hint_not_multiple", Args: []any{divisor}}
+	}
+}
+
+// DigitSum hints how the sum of the digits of the number compares with the
+// sum of the digits of the guess.
+type DigitSum struct{}
+
+// Hint compares the digit sums of the number and the last guess.
+func (DigitSum) Hint(gameState game.GameState) Hint {
+	turn, _ := gameState.GetLastTurn()
+	number := digitSum(gameState.Target())
+	guess := digitSum(turn.GuessNumber)
+
+	switch {
+	case number > guess:
+		return Hint{Key: "digit_sum_greater", Args: []any{guess}}
+	case number < guess:
+		return Hint{Key: "digit_sum_less", Args: []any{guess}}
+	default:
+		return Hint{Key: "digit_sum_equal", Args: []any{guess}}
+	}
+}
+
+// None gives no hint, leaving only whether the number is greater or less.
+type None struct{}
+
+// Hint returns no hint.
+func (None) Hint(game.GameState) Hint {
+	return Hint{}
+}
+
+func difference(turn game.Turn) int {
+	if turn.Difference == nil {
+		return 0
+	}
+	return *turn.Difference
+}
+
+func digitSum(number int) int {
+	if number < 0 {
+		number = -number
+	}
+
+	var sum int
+	for ; number > 0; number /= 10 {
+		sum += number % 10
+	}
+	return sum
+}
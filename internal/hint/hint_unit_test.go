@@ -0,0 +1,216 @@
+package hint_test
+
+import (
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNewStrategies(t *testing.T) {
+	t.Run("read the strategy of each level from the config", func(t *testing.T) {
+		got, err := hint.NewStrategies(map[string]string{
+			"easy":     "distance",
+			"Hard":     "hot_cold",
+			"adaptive": "proportional",
+		})
+
+		want := hint.Strategies{
+			"easy":     hint.Distance{},
+			"hard":     hint.HotCold{},
+			"adaptive": hint.Proportional{},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, hint.HotCold{}, got.For("Hard"))
+		assert.Equal(t, hint.Distance{}, got.For("Medium"))
+	})
+
+	t.Run("error when a strategy is unknown", func(t *testing.T) {
+		want := hint.NewStrategyError("easy", "psychic")
+		_, got := hint.NewStrategies(map[string]string{"easy": "psychic"})
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestUnitStrategyHint(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description  string
+			strategy     hint.Strategy
+			randomNumber int
+			min          int
+			max          int
+			guesses      []int
+			want         hint.Hint
+		}{
+			{
+				description:  "distance bucket",
+				strategy:     hint.Distance{},
+				randomNumber: 50,
+				guesses:      []int{48},
+				want:         hint.Hint{Key: "very_close_2"},
+			},
+			{
+				description:  "distance bucket regardless of the range",
+				strategy:     hint.Distance{},
+				randomNumber: 500,
+				min:          1,
+				max:          1000,
+				guesses:      []int{480},
+				want:         hint.Hint{Key: "very_far"},
+			},
+			{
+				description:  "proportional bucket on the default range",
+				strategy:     hint.Proportional{},
+				randomNumber: 50,
+				guesses:      []int{48},
+				want:         hint.Hint{Key: "very_close_2"},
+			},
+			{
+				description:  "proportional bucket on a wide range",
+				strategy:     hint.Proportional{},
+				randomNumber: 500,
+				min:          1,
+				max:          1000,
+				guesses:      []int{480},
+				want:         hint.Hint{Key: "very_close_2"},
+			},
+			{
+				description:  "proportional bucket next to the number",
+				strategy:     hint.Proportional{},
+				randomNumber: 500,
+				min:          1,
+				max:          1000,
+				guesses:      []int{499},
+				want:         hint.Hint{Key: "very_close_1"},
+			},
+			{
+				description:  "first guess of hot and cold",
+				strategy:     hint.HotCold{},
+				randomNumber: 50,
+				guesses:      []int{10},
+				want:         hint.Hint{Key: "hot_first"},
+			},
+			{
+				description:  "warmer guess",
+				strategy:     hint.HotCold{},
+				randomNumber: 50,
+				guesses:      []int{10, 60},
+				want:         hint.Hint{Key: "warmer"},
+			},
+			{
+				description:  "colder guess",
+				strategy:     hint.HotCold{},
+				randomNumber: 50,
+				guesses:      []int{60, 10},
+				want:         hint.Hint{Key: "colder"},
+			},
+			{
+				description:  "as warm guess",
+				strategy:     hint.HotCold{},
+				randomNumber: 50,
+				guesses:      []int{40, 60},
+				want:         hint.Hint{Key: "as_warm"},
+			},
+			{
+				description:  "parity",
+				strategy:     hint.Divisibility{},
+				randomNumber: 45,
+				guesses:      []int{10},
+				want:         hint.Hint{Key: "hint_odd"},
+			},
+			{
+				description:  "multiple",
+				strategy:     hint.Divisibility{},
+				randomNumber: 45,
+				guesses:      []int{10, 20},
+				want:         hint.Hint{Key: "hint_multiple", Args: []any{3}},
+			},
+			{
+				description:  "not a multiple",
+				strategy:     hint.Divisibility{},
+				randomNumber: 45,
+				guesses:      []int{10, 20, 30, 40},
+				want:         hint.Hint{Key: "hint_not_multiple", Args: []any{7}},
+			},
+			{
+				description:  "parity again after every divisor",
+				strategy:     hint.Divisibility{},
+				randomNumber: 44,
+				guesses:      []int{10, 20, 30, 40, 41},
+				want:         hint.Hint{Key: "hint_even"},
+			},
+			{
+				description:  "greater digit sum",
+				strategy:     hint.DigitSum{},
+				randomNumber: 58,
+				guesses:      []int{31},
+				want:         hint.Hint{Key: "digit_sum_greater", Args: []any{4}},
+			},
+			{
+				description:  "less digit sum",
+				strategy:     hint.DigitSum{},
+				randomNumber: 20,
+				guesses:      []int{19},
+				want:         hint.Hint{Key: "digit_sum_less", Args: []any{10}},
+			},
+			{
+				description:  "equal digit sum",
+				strategy:     hint.DigitSum{},
+				randomNumber: 37,
+				guesses:      []int{73},
+				want:         hint.Hint{Key: "digit_sum_equal", Args: []any{10}},
+			},
+			{
+				description:  "no hint",
+				strategy:     hint.None{},
+				randomNumber: 50,
+				guesses:      []int{10},
+				want:         hint.Hint{},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: tc.randomNumber,
+					Turns:        game.Turns{},
+					Min:          tc.min,
+					Max:          tc.max,
+				}
+				for _, guess := range tc.guesses {
+					assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+				}
+
+				got := tc.strategy.Hint(gameState)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
+
+func TestUnitDistanceKey(t *testing.T) {
+	t.Run("return hint key for difference", func(t *testing.T) {
+		testCases := []struct {
+			difference int
+			want       string
+		}{
+			{difference: 1, want: "very_close_1"},
+			{difference: 5, want: "close_2"},
+			{difference: 6, want: "far"},
+			{difference: 10, want: "very_far"},
+		}
+
+		for _, tc := range testCases {
+			assert.Equal(t, tc.want, hint.DistanceKey(tc.difference))
+		}
+	})
+}
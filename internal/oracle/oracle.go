@@ -0,0 +1,200 @@
+// Package oracle implements the lying-oracle mode, a variant of Ulam's game
+// where some of the greater or less answers may be lies. It decides which
+// answers are lies, and solves and simulates rounds of the variant to tune
+// the lies allowed against the attempts of a level.
+package oracle
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/olekukonko/tablewriter"
+)
+
+// LiarError represents an error occurring when a setting of the lying-oracle
+// config isn't a number.
+type LiarError struct {
+	Key   string
+	Value string
+}
+
+// LiarMessage is the message displayed when a lying-oracle setting is
+// invalid. It is public for testing purposes.
+const LiarMessage = "Lying-oracle setting %q must be a number, got %q."
+
+// Error returns the error message for LiarError.
+func (e *LiarError) Error() string {
+	return fmt.Sprintf(LiarMessage, e.Key, e.Value)
+}
+
+// NewLiarError creates a new LiarError for testing.
+func NewLiarError(key, value string) error {
+	return &LiarError{Key: key, Value: value}
+}
+
+// Oracle decides whether the answer to the next guess of the round is a
+// lie. It is only asked while the round may still lie.
+type Oracle interface {
+	Lie(gameState game.GameState) bool
+}
+
+// Liar lies with the probability Rate, at most MaxLies times per round by
+// lower-cased level. A Rate of 1 lies as soon and as often as allowed.
+type Liar struct {
+	Rate    float64
+	MaxLies map[string]int
+}
+
+// NewLiar reads the liar from the flattened lying-oracle config, with the
+// lies allowed per level under "max_lies.<level>". Missing settings count as
+// zero.
+func NewLiar(config map[string]string) (Liar, error) {
+	liar := Liar{MaxLies: map[string]int{}}
+
+	for key, value := range config {
+		var err error
+		switch {
+		case strings.HasPrefix(key, "max_lies."):
+			level := strings.TrimPrefix(key, "max_lies.")
+			liar.MaxLies[level], err = strconv.Atoi(value)
+		case key == "rate":
+			liar.Rate, err = strconv.ParseFloat(value, 64)
+		}
+
+		if err != nil {
+			return Liar{}, NewLiarError(key, value)
+		}
+	}
+
+	return liar, nil
+}
+
+// Lie draws whether the answer is a lie.
+func (l Liar) Lie(game.GameState) bool {
+	return rand.Float64() < l.Rate
+}
+
+// For returns the number of lies allowed in a round of the level.
+func (l Liar) For(level string) int {
+	return l.MaxLies[strings.ToLower(level)]
+}
+
+// NextGuess returns the guess of the solver for the round: the weighted
+// median of the numbers not guessed yet that contradict at most MaxLies
+// answers, where each lie a number needs halves its weight. Found numbers
+// are never lied about, so every guess rules a number out for good.
+func NextGuess(gameState game.GameState) int {
+	low, high := gameState.Bounds()
+
+	guessed := map[int]bool{}
+	for _, turn := range gameState.Turns {
+		guessed[turn.GuessNumber] = true
+	}
+
+	var candidates, weights []int
+	var total int
+	for number := low; number <= high; number++ {
+		contradictions := gameState.Contradictions(number)
+		if guessed[number] || contradictions > gameState.MaxLies {
+			continue
+		}
+
+		weight := 1 << (gameState.MaxLies - contradictions)
+		candidates = append(candidates, number)
+		weights = append(weights, weight)
+		total += weight
+	}
+
+	var cumulative int
+	for i, number := range candidates {
+		cumulative += weights[i]
+		if cumulative*2 >= total {
+			return number
+		}
+	}
+
+	return low
+}
+
+// Guesses returns the number of guesses the solver needs to find the number
+// between low and high, against the oracle telling at most maxLies lies.
+func Guesses(low, high, number, maxLies int, oracle Oracle) int {
+	gameState := game.GameState{
+		Level:        game.AdaptiveLevel,
+		MaxAttempts:  high - low + 1,
+		RandomNumber: number,
+		Turns:        game.Turns{},
+		Min:          low,
+		Max:          high,
+		MaxLies:      maxLies,
+	}
+
+	for {
+		turn := game.Turn{GuessNumber: NextGuess(gameState)}
+		turn.TellLie = gameState.LiesLeft() > 0 && oracle.Lie(gameState)
+		if err := gameState.PlayTurn(turn); err != nil {
+			return len(gameState.Turns)
+		}
+
+		last, _ := gameState.GetLastTurn()
+		if *last.Outcome == game.Equal {
+			return len(gameState.Turns)
+		}
+	}
+}
+
+// Result is the simulation of the solver for a number of lies allowed: the
+// guesses needed for the worst number of the range and on average.
+type Result struct {
+	MaxLies int
+	Worst   int
+	Mean    float64
+}
+
+// Results holds the simulations for increasing numbers of lies.
+type Results []Result
+
+// Simulate plays the solver against the oracle for every number between low
+// and high, allowing from no lies up to maxLies, to compare the lies allowed
+// with the attempts of a level.
+func Simulate(low, high, maxLies int, oracle Oracle) Results {
+	results := Results{}
+
+	for lies := 0; lies <= maxLies; lies++ {
+		result := Result{MaxLies: lies}
+
+		var sum int
+		for number := low; number <= high; number++ {
+			guesses := Guesses(low, high, number, lies, oracle)
+			result.Worst = max(result.Worst, guesses)
+			sum += guesses
+		}
+		result.Mean = float64(sum) / float64(high-low+1)
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// String formats the results as a table.
+func (r Results) String() string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Lies", "Worst", "Mean"})
+
+	for _, result := range r {
+		table.Append([]string{
+			strconv.Itoa(result.MaxLies),
+			strconv.Itoa(result.Worst),
+			strconv.FormatFloat(result.Mean, 'f', 2, 64),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
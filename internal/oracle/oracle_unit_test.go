@@ -0,0 +1,130 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/oracle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNewLiar(t *testing.T) {
+	t.Run("read the liar from the config", func(t *testing.T) {
+		got, err := oracle.NewLiar(map[string]string{
+			"rate":           "0.25",
+			"max_lies.easy":  "2",
+			"max_lies.hard":  "1",
+			"unknown.string": "ignored",
+		})
+
+		want := oracle.Liar{
+			Rate:    0.25,
+			MaxLies: map[string]int{"easy": 2, "hard": 1},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, 2, got.For("Easy"))
+		assert.Equal(t, 0, got.For("Medium"))
+	})
+
+	t.Run("error when a setting isn't a number", func(t *testing.T) {
+		want := oracle.NewLiarError("max_lies.easy", "some")
+		_, got := oracle.NewLiar(map[string]string{"max_lies.easy": "some"})
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestUnitLiarLie(t *testing.T) {
+	t.Run("lie always or never at the bounds of the rate", func(t *testing.T) {
+		for range 10 {
+			assert.True(t, oracle.Liar{Rate: 1}.Lie(game.GameState{}))
+			assert.False(t, oracle.Liar{Rate: 0}.Lie(game.GameState{}))
+		}
+	})
+}
+
+func TestUnitNextGuess(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			maxLies     int
+			guesses     []int
+			want        int
+		}{
+			{description: "middle without lies", guesses: []int{}, want: 50},
+			{description: "middle of the narrowed range", guesses: []int{50}, want: 75},
+			{description: "middle with lies", maxLies: 1, guesses: []int{}, want: 50},
+			{
+				description: "middle weighing the numbers needing a lie",
+				maxLies:     1,
+				guesses:     []int{50},
+				want:        63,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gameState := game.GameState{
+					Level:        "Easy",
+					MaxAttempts:  10,
+					RandomNumber: 71,
+					Turns:        game.Turns{},
+					MaxLies:      tc.maxLies,
+				}
+				for _, guess := range tc.guesses {
+					assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+				}
+
+				got := oracle.NextGuess(gameState)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
+
+func TestUnitGuesses(t *testing.T) {
+	t.Run("find the number despite the lies", func(t *testing.T) {
+		for _, maxLies := range []int{0, 1, 2} {
+			for number := 1; number <= 100; number++ {
+				got := oracle.Guesses(1, 100, number, maxLies, oracle.Liar{Rate: 1})
+				assert.Positive(t, got)
+				assert.LessOrEqual(t, got, 7+8*maxLies)
+			}
+		}
+	})
+
+	t.Run("search in binary without lies", func(t *testing.T) {
+		assert.Equal(t, 1, oracle.Guesses(1, 100, 50, 0, oracle.Liar{}))
+		assert.Equal(t, 7, oracle.Guesses(1, 100, 100, 0, oracle.Liar{}))
+	})
+}
+
+func TestUnitSimulate(t *testing.T) {
+	t.Run("need more guesses as the lies allowed grow", func(t *testing.T) {
+		got := oracle.Simulate(1, 100, 2, oracle.Liar{Rate: 1})
+
+		assert.Len(t, got, 3)
+		assert.Equal(t, 7, got[0].Worst)
+		for i := 1; i < len(got); i++ {
+			assert.Equal(t, i, got[i].MaxLies)
+			assert.Greater(t, got[i].Worst, got[i-1].Worst)
+			assert.Greater(t, got[i].Mean, got[i-1].Mean)
+		}
+	})
+
+	t.Run("format the results", func(t *testing.T) {
+		results := oracle.Results{{MaxLies: 0, Worst: 7, Mean: 5.8}}
+
+		want := "" +
+			"+------+-------+------+\n" +
+			"| LIES | WORST | MEAN |\n" +
+			"+------+-------+------+\n" +
+			"|    0 |     7 | 5.80 |\n" +
+			"+------+-------+------+\n"
+		assert.Equal(t, want, results.String())
+	})
+}
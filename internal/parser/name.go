@@ -0,0 +1,164 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// NameLimits are the smallest and largest display widths of a player name,
+// in terminal columns, wide East Asian characters and emoji taking two.
+type NameLimits struct {
+	MinWidth int
+	MaxWidth int
+}
+
+// DefaultNameLimits are the name limits used when none are configured.
+var DefaultNameLimits = NameLimits{MinWidth: 1, MaxWidth: 20}
+
+// NameLimitsError indicates an error when the configured name limits aren't
+// whole numbers from 1, the smallest width being at most the largest.
+type NameLimitsError struct {
+	MinWidth string
+	MaxWidth string
+}
+
+// NameLimitsMessage is the message displayed when the name limits are
+// invalid. It is public for testing purposes.
+const NameLimitsMessage = "Name widths must be whole numbers from 1, min_width " +
+	"at most max_width, got %q and %q."
+
+// Error returns the error message for NameLimitsError.
+func (e *NameLimitsError) Error() string {
+	return fmt.Sprintf(NameLimitsMessage, e.MinWidth, e.MaxWidth)
+}
+
+// NewNameLimitsError creates a new instance of NameLimitsError for testing.
+func NewNameLimitsError(minWidth, maxWidth string) error {
+	return &NameLimitsError{MinWidth: minWidth, MaxWidth: maxWidth}
+}
+
+// NewNameLimits reads the name limits from the flattened names config,
+// under "min_width" and "max_width", each defaulting to DefaultNameLimits.
+func NewNameLimits(config map[string]string) (NameLimits, error) {
+	minWidth, ok := config["min_width"]
+	if !ok {
+		minWidth = strconv.Itoa(DefaultNameLimits.MinWidth)
+	}
+	maxWidth, ok := config["max_width"]
+	if !ok {
+		maxWidth = strconv.Itoa(DefaultNameLimits.MaxWidth)
+	}
+
+	low, lowErr := strconv.Atoi(minWidth)
+	high, highErr := strconv.Atoi(maxWidth)
+	if lowErr != nil || highErr != nil || low < 1 || high < low {
+		return NameLimits{}, NewNameLimitsError(minWidth, maxWidth)
+	}
+
+	return NameLimits{MinWidth: low, MaxWidth: high}, nil
+}
+
+// ParsePlayerControlError indicates an error when the player name contains
+// control characters, such as the escape sequences styling terminals.
+type ParsePlayerControlError struct{}
+
+// ParsePlayerControlMessage is the message displayed when the player name
+// contains control characters. It is public for testing purposes.
+const ParsePlayerControlMessage = "It must not contain control characters " +
+	"or escape sequences."
+
+// Error returns the error message for ParsePlayerControlError.
+func (e *ParsePlayerControlError) Error() string {
+	return fmt.Sprint(ParsePlayerControlMessage)
+}
+
+// NewParsePlayerControlError creates a new instance of
+// ParsePlayerControlError for testing.
+func NewParsePlayerControlError() error {
+	return &ParsePlayerControlError{}
+}
+
+// Parse validates the player name and returns it normalized: composed in
+// Unicode NFC form, so that "Zoë" is the same however the ë was typed, with
+// no leading, trailing or repeated spaces. The name must not contain
+// control characters, which include the escape sequences breaking the
+// leaderboard table, and its display width must be within the limits, the
+// zero NameLimits being DefaultNameLimits.
+func (l NameLimits) Parse(s string) (string, error) {
+	if l == (NameLimits{}) {
+		l = DefaultNameLimits
+	}
+
+	for _, r := range s {
+		if isControl(r) {
+			return "", NewParsePlayerControlError()
+		}
+	}
+
+	name := strings.Join(strings.Fields(norm.NFC.String(s)), " ")
+	if w := DisplayWidth(name); w < l.MinWidth || w > l.MaxWidth {
+		return "", NewParsePlayerError(l.MinWidth, l.MaxWidth)
+	}
+
+	return name, nil
+}
+
+// zeroWidthJoiner joins emoji into one, such as the members of a family.
+const zeroWidthJoiner = '\u200d'
+
+// isControl reports whether the rune is a control or invisible formatting
+// character, other than the joiners of emoji and scripts, or a line or
+// paragraph separator.
+func isControl(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner, r == '\u200c':
+		return false
+	case unicode.IsControl(r):
+		return true
+	}
+	return unicode.In(r, unicode.Cf, unicode.Zl, unicode.Zp)
+}
+
+// DisplayWidth returns the terminal columns of the string, counting every
+// grapheme cluster, a character with the marks, variation selectors, skin
+// tones and joined characters following it, as wide as its first
+// character: two columns for wide East Asian characters and emoji, one
+// otherwise. A pair of regional indicators is a flag, two columns wide.
+func DisplayWidth(s string) int {
+	columns := 0
+	joined, flag := false, false
+	for _, r := range s {
+		switch {
+		case joined:
+			joined = false
+			continue
+		case r == zeroWidthJoiner:
+			joined = true
+			continue
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc),
+			unicode.Is(unicode.Variation_Selector, r),
+			r >= 0x1f3fb && r <= 0x1f3ff:
+			continue
+		case r >= 0x1f1e6 && r <= 0x1f1ff:
+			flag = !flag
+			if flag {
+				columns += 2
+			}
+			continue
+		}
+
+		flag = false
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			columns += 2
+		default:
+			columns++
+		}
+	}
+	return columns
+}
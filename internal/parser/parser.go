@@ -6,32 +6,35 @@ package parser
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
-// ParsePlayerError indicates an error when parsing player name input.
-type ParsePlayerError struct{}
+// ParsePlayerError indicates an error when the player name is too narrow
+// or too wide.
+type ParsePlayerError struct {
+	MinWidth int
+	MaxWidth int
+}
 
-// ParsePlayerMessage is the message displayed when the player name exceeds
-// 20 characters. It is public for testing purposes.
-const ParsePlayerMessage = "It must be non-empty, and 20 characters at most."
+// ParsePlayerMessage is the message displayed when the player name is empty
+// or too wide. It is public for testing purposes.
+const ParsePlayerMessage = "It must be non-empty, and %d to %d characters " +
+	"wide, wide characters counting twice."
 
 // Error returns the error message for ParsePlayerError.
 func (e *ParsePlayerError) Error() string {
-	return fmt.Sprint(ParsePlayerMessage)
+	return fmt.Sprintf(ParsePlayerMessage, e.MinWidth, e.MaxWidth)
 }
 
 // NewParsePlayerError creates a new instance of ParsePlayerError for testing.
-func NewParsePlayerError() error {
-	return &ParsePlayerError{}
+func NewParsePlayerError(minWidth, maxWidth int) error {
+	return &ParsePlayerError{MinWidth: minWidth, MaxWidth: maxWidth}
 }
 
-// ParsePlayerInput validates the player name, ensuring it is not empty and
-// does not exceed 20 characters. Returns a custom error if validation fails.
+// ParsePlayerInput validates and normalizes the player name within the
+// DefaultNameLimits. Returns a custom error if validation fails.
 func ParsePlayerInput(s string) (string, error) {
-	if len(s) > 20 || s == "" {
-		return "", NewParsePlayerError()
-	}
-	return s, nil
+	return DefaultNameLimits.Parse(s)
 }
 
 // ParseNumberError indicates an error when parsing number input.
@@ -76,6 +79,82 @@ func ParseGuessNumberInput(s string) (int, error) {
 	return validateInputNumber(s, 1, 100)
 }
 
+// Command is an in-game command entered at the guess prompt instead of a
+// number. Commands start with a colon.
+type Command string
+
+// In-game commands available at the guess prompt.
+const (
+	CommandHistory Command = ":history"
+	CommandRange   Command = ":range"
+	CommandHint    Command = ":hint"
+	CommandGiveUp  Command = ":giveup"
+	CommandScores  Command = ":scores"
+	CommandHelp    Command = ":help"
+	CommandQuit    Command = ":quit"
+)
+
+// Commands lists the in-game commands in the order they are documented.
+var Commands = []Command{
+	CommandHistory,
+	CommandRange,
+	CommandHint,
+	CommandGiveUp,
+	CommandScores,
+	CommandHelp,
+	CommandQuit,
+}
+
+// ParseCommandError indicates an error when an unknown command is entered.
+type ParseCommandError struct {
+	Command string
+}
+
+// ParseCommandMessage is the message displayed when the command is unknown.
+// It is public for testing purposes.
+const ParseCommandMessage = "Unknown command %q. Type :help to list commands."
+
+// Error returns the error message for ParseCommandError.
+func (e *ParseCommandError) Error() string {
+	return fmt.Sprintf(ParseCommandMessage, e.Command)
+}
+
+// NewParseCommandError creates a new instance of ParseCommandError for
+// testing.
+func NewParseCommandError(command string) error {
+	return &ParseCommandError{Command: command}
+}
+
+// GuessInput holds what was entered at the guess prompt: either a guess
+// number, or an in-game command when Command is not empty.
+type GuessInput struct {
+	Number  int
+	Command Command
+}
+
+// ParseGuessInputBetween validates and returns the guess prompt input of a
+// round whose number is between min and max. Inputs starting with a colon
+// are parsed as case-insensitive in-game commands, anything else as a guess
+// number between min and max.
+func ParseGuessInputBetween(s string, min, max int) (GuessInput, error) {
+	if !strings.HasPrefix(s, ":") {
+		number, err := validateInputNumber(s, min, max)
+		if err != nil {
+			return GuessInput{}, err
+		}
+		return GuessInput{Number: number}, nil
+	}
+
+	command := Command(strings.ToLower(strings.TrimSpace(s)))
+	for _, c := range Commands {
+		if c == command {
+			return GuessInput{Command: command}, nil
+		}
+	}
+
+	return GuessInput{}, NewParseCommandError(s)
+}
+
 // ParsePlayAgainInput validates and returns the parsed play again input,
 // ensuring the user input is either 1 or 2. Returns a custom error if
 // validation fails.
@@ -95,9 +174,11 @@ func ParsePlayAgainInput(s string) (bool, error) {
 
 // ParseDifficultyInput validates and returns the parsed difficulty level
 // and maximum number of attempts based on user input, ensuring the input
-// is between 1 and 3. Returns a custom error if validation fails.
+// is between 1 and 4. The adaptive level has no fixed maximum number of
+// attempts, so it is returned as zero. Returns a custom error if validation
+// fails.
 func ParseDifficultyInput(s string) (string, int, error) {
-	integer, err := validateInputNumber(s, 1, 3)
+	integer, err := validateInputNumber(s, 1, 4)
 	if err != nil {
 		return "", 0, err
 	}
@@ -106,6 +187,7 @@ func ParseDifficultyInput(s string) (string, int, error) {
 		1: "Easy",
 		2: "Medium",
 		3: "Hard",
+		4: "Adaptive",
 	}
 
 	toMaxAttempts := map[int]int{
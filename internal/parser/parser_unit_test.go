@@ -9,39 +9,160 @@ import (
 
 func TestUnitParsePlayerInput(t *testing.T) {
 	t.Run("return parsed player", func(t *testing.T) {
-		value := "test"
+		testCases := []struct {
+			description string
+			value       string
+			want        string
+		}{
+			{
+				description: "ascii name",
+				value:       "test",
+				want:        "test",
+			},
+			{
+				description: "name without extra spaces",
+				value:       "  Mary   Ann ",
+				want:        "Mary Ann",
+			},
+			{
+				description: "decomposed accent composed",
+				value:       "Zoe\u0308",
+				want:        "Zo\u00eb",
+			},
+			{
+				description: "accented name of 20 characters",
+				value:       "ÉléonoreÉléonoreÉléo",
+				want:        "ÉléonoreÉléonoreÉléo",
+			},
+			{
+				description: "wide name of 20 columns",
+				value:       "山田太郎山田太郎山田",
+				want:        "山田太郎山田太郎山田",
+			},
+		}
 
-		got, err := parser.ParsePlayerInput(value)
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got, err := parser.ParsePlayerInput(tc.value)
 
-		assert.NoError(t, err)
-		assert.Equal(t, value, got)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
 	})
 
 	testCases := []struct {
 		description string
 		value       string
+		want        error
 	}{
 		{
 			description: "more than 20 chars",
 			value:       "aaaaaaaaaaaaaaaaaaaaa", // 21 'a's
+			want:        parser.NewParsePlayerError(1, 20),
+		},
+		{
+			description: "more than 20 columns",
+			value:       "山田太郎山田太郎山田太",
+			want:        parser.NewParsePlayerError(1, 20),
 		},
 		{
 			description: "empty player name",
 			value:       "",
+			want:        parser.NewParsePlayerError(1, 20),
+		},
+		{
+			description: "blank player name",
+			value:       "   ",
+			want:        parser.NewParsePlayerError(1, 20),
+		},
+		{
+			description: "ansi escape sequence",
+			value:       "\x1b[31mbob\x1b[0m",
+			want:        parser.NewParsePlayerControlError(),
+		},
+		{
+			description: "tab character",
+			value:       "bob\tann",
+			want:        parser.NewParsePlayerControlError(),
+		},
+		{
+			description: "bidi override",
+			value:       "bob\u202eann",
+			want:        parser.NewParsePlayerControlError(),
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
-			want := parser.NewParsePlayerError()
 			_, got := parser.ParsePlayerInput(tc.value)
 
 			assert.NotNil(t, got)
-			assert.ErrorAs(t, got, &want)
+			assert.ErrorAs(t, got, &tc.want)
+			assert.Equal(t, tc.want.Error(), got.Error())
 		})
 	}
 }
 
+func TestUnitNameLimits(t *testing.T) {
+	t.Run("return the configured name limits", func(t *testing.T) {
+		got, err := parser.NewNameLimits(map[string]string{
+			"min_width": "2",
+			"max_width": "8",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, parser.NameLimits{MinWidth: 2, MaxWidth: 8}, got)
+
+		_, err = got.Parse("b")
+		assert.Equal(t, parser.NewParsePlayerError(2, 8), err)
+		name, err := got.Parse("bob")
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", name)
+	})
+
+	t.Run("return the default name limits without config", func(t *testing.T) {
+		got, err := parser.NewNameLimits(map[string]string{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, parser.DefaultNameLimits, got)
+	})
+
+	t.Run("error when the limits are invalid", func(t *testing.T) {
+		_, got := parser.NewNameLimits(map[string]string{
+			"min_width": "10",
+			"max_width": "5",
+		})
+
+		want := parser.NewNameLimitsError("10", "5")
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestUnitDisplayWidth(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			value       string
+			want        int
+		}{
+			{description: "ascii", value: "bob", want: 3},
+			{description: "combining mark", value: "Zoe\u0308", want: 3},
+			{description: "wide characters", value: "山田", want: 4},
+			{description: "emoji with skin tone", value: "\U0001f44d\U0001f3fd", want: 2},
+			{description: "joined emoji", value: "\U0001f469\u200d\U0001f4bb", want: 2},
+			{description: "flag", value: "\U0001f1eb\U0001f1f7", want: 2},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				assert.Equal(t, tc.want, parser.DisplayWidth(tc.value))
+			})
+		}
+	})
+}
+
 func TestUnitParseGuessNumberInput(t *testing.T) {
 	t.Run("return parsed guess number between 1 and 100",
 		func(t *testing.T) {
@@ -112,6 +233,71 @@ func TestUnitParseGuessNumberInput(t *testing.T) {
 	})
 }
 
+func TestUnitParseGuessInputBetween(t *testing.T) {
+	t.Run("return parsed guess input", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			value       string
+			want        parser.GuessInput
+		}{
+			{
+				description: "guess number",
+				value:       "50",
+				want:        parser.GuessInput{Number: 50},
+			},
+			{
+				description: "command",
+				value:       ":range",
+				want:        parser.GuessInput{Command: parser.CommandRange},
+			},
+			{
+				description: "case-insensitive command",
+				value:       ":GiveUp ",
+				want:        parser.GuessInput{Command: parser.CommandGiveUp},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got, err := parser.ParseGuessInputBetween(tc.value, 1, 100)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("error when unknown command", func(t *testing.T) {
+		want := parser.NewParseCommandError(":unknown")
+		_, got := parser.ParseGuessInputBetween(":unknown", 1, 100)
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+
+	t.Run("return guess number within the range of the round", func(t *testing.T) {
+		got, err := parser.ParseGuessInputBetween("150", 1, 200)
+
+		assert.NoError(t, err)
+		assert.Equal(t, parser.GuessInput{Number: 150}, got)
+
+		want := parser.NewNumberRangeError(1, 50)
+		_, err = parser.ParseGuessInputBetween("51", 1, 50)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, want.Error(), err.Error())
+	})
+
+	t.Run("error when invalid guess number", func(t *testing.T) {
+		want := parser.NewNumberRangeError(1, 100)
+		_, got := parser.ParseGuessInputBetween("101", 1, 100)
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+}
+
 func TestUnitParsePlayAgainInput(t *testing.T) {
 	t.Run("return parsed play again as bool",
 		func(t *testing.T) {
@@ -203,6 +389,12 @@ func TestUnitParseDifficultyInput(t *testing.T) {
 				wantLevel:       "Hard",
 				wantMaxAttempts: 3,
 			},
+			{
+				description:     "adaptive level has no fixed max attempts",
+				value:           "4",
+				wantLevel:       "Adaptive",
+				wantMaxAttempts: 0,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -234,13 +426,13 @@ func TestUnitParseDifficultyInput(t *testing.T) {
 				value:       "0",
 			},
 			{
-				description: "greater than 3",
-				value:       "4",
+				description: "greater than 4",
+				value:       "5",
 			},
 		}
 
 		for _, tc := range testCases {
-			want := parser.NewNumberRangeError(1, 3)
+			want := parser.NewNumberRangeError(1, 4)
 			_, _, got := parser.ParseDifficultyInput(tc.value)
 
 			assert.NotNil(t, got)
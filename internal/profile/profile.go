@@ -0,0 +1,241 @@
+// Package profile keeps the profiles of the players, so that a player is
+// known by one name however it is typed, and keeps the preferred level,
+// locale and theme of the player between sessions.
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NoProfiles is the message displayed when no profile was created yet. It
+// is public for testing purposes.
+const NoProfiles = "No profiles yet."
+
+// NoProfileError represents an error occurring when no profile has the
+// name.
+type NoProfileError struct {
+	Name string
+}
+
+// NoProfileMessage is the message displayed when the profile doesn't exist.
+// It is public for testing purposes.
+const NoProfileMessage = "No profile is named %q."
+
+// Error returns the error message for NoProfileError.
+func (e *NoProfileError) Error() string {
+	return fmt.Sprintf(NoProfileMessage, e.Name)
+}
+
+// NewNoProfileError creates a new NoProfileError for testing.
+func NewNoProfileError(name string) error {
+	return &NoProfileError{Name: name}
+}
+
+// ThemeError represents an error occurring when the preferred theme isn't
+// defined in the themes config.
+type ThemeError struct {
+	Theme string
+}
+
+// ThemeMessage is the message displayed when the theme is unknown. It is
+// public for testing purposes.
+const ThemeMessage = "No theme is named %q in configs/themes.yaml."
+
+// Error returns the error message for ThemeError.
+func (e *ThemeError) Error() string {
+	return fmt.Sprintf(ThemeMessage, e.Theme)
+}
+
+// NewThemeError creates a new ThemeError for testing.
+func NewThemeError(theme string) error {
+	return &ThemeError{Theme: theme}
+}
+
+// LocaleError represents an error occurring when the preferred locale has
+// no message config in configs/locales.
+type LocaleError struct {
+	Locale string
+}
+
+// LocaleMessage is the message displayed when the locale is unknown. It is
+// public for testing purposes.
+const LocaleMessage = "No locale is named %q in configs/locales."
+
+// Error returns the error message for LocaleError.
+func (e *LocaleError) Error() string {
+	return fmt.Sprintf(LocaleMessage, e.Locale)
+}
+
+// NewLocaleError creates a new LocaleError for testing.
+func NewLocaleError(locale string) error {
+	return &LocaleError{Locale: locale}
+}
+
+// Normalize returns the unique name of a player name: case folded, in
+// Unicode NFKC form, with no leading, trailing or repeated spaces, so that
+// "Bob" and "bob " are the same player, as are "Ｂｏｂ" and "bob".
+func Normalize(name string) string {
+	return cases.Fold().String(norm.NFKC.String(DisplayName(name)))
+}
+
+// DisplayName returns the player name as it is shown, in Unicode NFC form,
+// with no leading, trailing or repeated spaces.
+func DisplayName(name string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(name)), " ")
+}
+
+// Key returns the key that stores hold the records of a player under: the
+// profile ID when the player has a profile, so that renaming keeps them, and
+// the player name otherwise, as before profiles.
+func Key(name string, id int) string {
+	if id > 0 {
+		return "profile:" + strconv.Itoa(id)
+	}
+	return name
+}
+
+// Profile is the identity of a player. Name is the normalized unique name,
+// and DisplayName the name as first entered. Level is the preferred
+// difficulty choice, from 1 to 4, played without asking, Locale the locale
+// of the messages, and Theme the preferred color theme, each unset when the
+// player has no preference.
+type Profile struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	Created     time.Time `json:"created"`
+	Level       string    `json:"level,omitempty"`
+	Locale      string    `json:"locale,omitempty"`
+	Theme       string    `json:"theme,omitempty"`
+}
+
+// Profiles holds the profiles in the order they were created.
+type Profiles []Profile
+
+// Find returns the profile whose unique name is the normalized name, and
+// whether it exists.
+func (p Profiles) Find(name string) (Profile, bool) {
+	name = Normalize(name)
+	for _, profile := range p {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return Profile{}, false
+}
+
+// Get returns the profile with the ID, and whether it exists.
+func (p Profiles) Get(id int) (Profile, bool) {
+	for _, profile := range p {
+		if profile.ID == id {
+			return profile, true
+		}
+	}
+	return Profile{}, false
+}
+
+// Create adds the profile of the player name created at now, with the next
+// ID, or returns the existing profile of the name.
+func (p *Profiles) Create(name string, now time.Time) Profile {
+	if profile, ok := p.Find(name); ok {
+		return profile
+	}
+
+	id := 1
+	for _, profile := range *p {
+		id = max(id, profile.ID+1)
+	}
+
+	profile := Profile{
+		ID:          id,
+		Name:        Normalize(name),
+		DisplayName: DisplayName(name),
+		Created:     now,
+	}
+	*p = append(*p, profile)
+	return profile
+}
+
+// Update replaces the profile with the same ID.
+func (p Profiles) Update(profile Profile) {
+	for i := range p {
+		if p[i].ID == profile.ID {
+			p[i] = profile
+		}
+	}
+}
+
+// String formats the profiles as an ASCII table.
+func (p Profiles) String() string {
+	if len(p) == 0 {
+		return NoProfiles
+	}
+
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"ID", "Name", "Level", "Locale", "Theme", "Created"})
+
+	for _, profile := range p {
+		table.Append([]string{
+			strconv.Itoa(profile.ID),
+			profile.DisplayName,
+			profile.Level,
+			profile.Locale,
+			profile.Theme,
+			profile.Created.Format("2006-01-02"),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Store defines methods for loading and saving the profiles, facilitating
+// testing.
+type Store interface {
+	Load() Profiles
+	Save(profiles Profiles) error
+}
+
+// FileStore manages the file path of the profiles, which must be a JSON
+// file.
+type FileStore struct {
+	FilePath string
+}
+
+// Load retrieves the profiles. If none were saved, it returns an empty
+// Profiles collection.
+func (s *FileStore) Load() Profiles {
+	profiles := Profiles{}
+
+	byt, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return profiles
+	}
+
+	if err = json.Unmarshal(byt, &profiles); err != nil {
+		return Profiles{}
+	}
+
+	return profiles
+}
+
+// Save writes the profiles, replacing the previous ones.
+func (s *FileStore) Save(profiles Profiles) error {
+	byt, err := json.Marshal(profiles)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.FilePath, byt, 0o644)
+}
@@ -0,0 +1,118 @@
+package profile_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationNormalize(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			name        string
+			want        string
+			wantDisplay string
+		}{
+			{
+				description: "lower case name",
+				name:        "Bob",
+				want:        "bob",
+				wantDisplay: "Bob",
+			},
+			{
+				description: "name without extra spaces",
+				name:        "  Mary   Ann ",
+				want:        "mary ann",
+				wantDisplay: "Mary Ann",
+			},
+			{
+				description: "fullwidth name folded",
+				name:        "Ｂｏｂ",
+				want:        "bob",
+				wantDisplay: "Ｂｏｂ",
+			},
+			{
+				description: "decomposed accent composed",
+				name:        "Zoe\u0308",
+				want:        "zo\u00eb",
+				wantDisplay: "Zo\u00eb",
+			},
+			{
+				description: "sharp s folded",
+				name:        "STRASSE",
+				want:        profile.Normalize("straße"),
+				wantDisplay: "STRASSE",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				assert.Equal(t, tc.want, profile.Normalize(tc.name))
+				assert.Equal(t, tc.wantDisplay, profile.DisplayName(tc.name))
+			})
+		}
+	})
+}
+
+func TestIntegrationProfilesCreate(t *testing.T) {
+	t.Run("create one profile per normalized name", func(t *testing.T) {
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		profiles := profile.Profiles{}
+
+		bob := profiles.Create("Bob", now)
+		again := profiles.Create("bob ", now.Add(time.Hour))
+		ann := profiles.Create("Ann", now)
+
+		assert.Equal(t, profile.Profile{
+			ID:          1,
+			Name:        "bob",
+			DisplayName: "Bob",
+			Created:     now,
+		}, bob)
+		assert.Equal(t, bob, again)
+		assert.Equal(t, 2, ann.ID)
+		assert.Len(t, profiles, 2)
+
+		got, ok := profiles.Get(2)
+		assert.True(t, ok)
+		assert.Equal(t, ann, got)
+		_, ok = profiles.Find("carol")
+		assert.False(t, ok)
+	})
+
+	t.Run("update the preferences of a profile", func(t *testing.T) {
+		profiles := profile.Profiles{}
+		bob := profiles.Create("Bob", time.Now())
+
+		bob.Level, bob.Locale, bob.Theme = "2", "fr", "mono"
+		profiles.Update(bob)
+
+		got, _ := profiles.Find("BOB")
+		assert.Equal(t, bob, got)
+		assert.Contains(t, profiles.String(), "| NAME | LEVEL | LOCALE | THEME |")
+		assert.Contains(t, profiles.String(), "| Bob  |     2 | fr     | mono  |")
+	})
+
+	t.Run("return message to user when no profiles", func(t *testing.T) {
+		assert.Equal(t, profile.NoProfiles, profile.Profiles{}.String())
+	})
+}
+
+func TestIntegrationFileStore(t *testing.T) {
+	t.Run("save and load the profiles", func(t *testing.T) {
+		fileStore := &profile.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "profiles.json"),
+		}
+		assert.Equal(t, profile.Profiles{}, fileStore.Load())
+
+		saved := profile.Profiles{}
+		saved.Create("Bob", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		assert.NoError(t, fileStore.Save(saved))
+
+		assert.Equal(t, saved, fileStore.Load())
+	})
+}
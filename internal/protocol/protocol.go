@@ -0,0 +1,386 @@
+// Package protocol implements a JSON Lines protocol over standard input and
+// output, so bots and other programs can play the game without reading the
+// English prompts. Input reads one request per line as a cli.InputSource,
+// and Output writes one event per line as a service reporter and engine
+// subscriber.
+package protocol
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/store"
+)
+
+// RequestTypeError indicates a request whose type doesn't match the input the
+// game is asking for. Value is the text of the request, which still answers
+// the prompt of its type.
+type RequestTypeError struct {
+	Want  string
+	Got   string
+	Value string
+}
+
+// RequestTypeMessage is the message displayed when the request type is
+// unexpected. It is public for testing purposes.
+const RequestTypeMessage = "Request type must be %q, got %q."
+
+// Error returns the error message for RequestTypeError.
+func (e *RequestTypeError) Error() string {
+	return fmt.Sprintf(RequestTypeMessage, e.Want, e.Got)
+}
+
+// Answer returns the type and the text of the request.
+func (e *RequestTypeError) Answer() (string, string) {
+	return e.Got, e.Value
+}
+
+// NewRequestTypeError creates a new instance of RequestTypeError for testing.
+func NewRequestTypeError(want, got string) error {
+	return &RequestTypeError{Want: want, Got: got}
+}
+
+// RequestFormatError wraps an error that occurs when a request line isn't a
+// valid JSON object.
+type RequestFormatError struct {
+	Err error
+}
+
+// RequestFormatMessage is displayed when a request can't be decoded,
+// formatted to include the underlying error.
+const RequestFormatMessage = "Request must be a JSON object: %w"
+
+// Error returns the formatted error message for RequestFormatError.
+func (e *RequestFormatError) Error() string {
+	return fmt.Errorf(RequestFormatMessage, e.Err).Error()
+}
+
+// NewRequestFormatError creates a new RequestFormatError instance with the
+// specified underlying error.
+func NewRequestFormatError(err error) error {
+	return &RequestFormatError{Err: err}
+}
+
+// Request is a command read from the input, such as
+// {"type": "guess", "value": 50}. Its type is one of the cli input kinds,
+// and its value is either a JSON string or a number.
+type Request struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Text returns the request value as the text a player would have typed.
+func (r Request) Text() string {
+	var text string
+	if err := json.Unmarshal(r.Value, &text); err == nil {
+		return text
+	}
+
+	return string(r.Value)
+}
+
+// Input implements the cli.InputSource interface, reading one JSON request
+// per line from the source. It returns io.EOF once the source is exhausted.
+type Input struct {
+	Source  io.Reader
+	scanner *bufio.Scanner
+}
+
+// NextPlayerInput retrieves the value of the next "player" request.
+func (i *Input) NextPlayerInput() (string, error) {
+	return i.next(cli.InputPlayer)
+}
+
+// NextDifficultyInput retrieves the value of the next "difficulty" request.
+func (i *Input) NextDifficultyInput() (string, error) {
+	return i.next(cli.InputDifficulty)
+}
+
+// NextGuessNumberInput retrieves the value of the next "guess" request.
+func (i *Input) NextGuessNumberInput() (string, error) {
+	return i.next(cli.InputGuess)
+}
+
+// NextPlayAgainInput retrieves the value of the next "play_again" request.
+func (i *Input) NextPlayAgainInput() (string, error) {
+	return i.next(cli.InputPlayAgain)
+}
+
+func (i *Input) next(want string) (string, error) {
+	if i.scanner == nil {
+		i.scanner = bufio.NewScanner(i.Source)
+	}
+
+	if !i.scanner.Scan() {
+		if err := i.scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+
+	var request Request
+	if err := json.Unmarshal(i.scanner.Bytes(), &request); err != nil {
+		return "", NewRequestFormatError(err)
+	}
+
+	if request.Type != want {
+		return "", &RequestTypeError{
+			Want:  want,
+			Got:   request.Type,
+			Value: request.Text(),
+		}
+	}
+
+	return request.Text(), nil
+}
+
+// Event names written in the "event" field of every output line.
+const (
+	EventPrompt      = "prompt"
+	EventStarted     = "started"
+	EventTurnResult  = "turn_result"
+	EventClue        = "clue"
+	EventExpired     = "expired"
+	EventGameOver    = "game_over"
+	EventLies        = "lies"
+	EventLeaderboard = "leaderboard"
+	EventError       = "error"
+)
+
+// PromptEvent asks for the input of the given kind.
+type PromptEvent struct {
+	Event string `json:"event"`
+	Input string `json:"input"`
+}
+
+// StartedEvent reports the start of a round, or its resumption when Resumed
+// is set. Commitment is the hash of the fairness commitment to the secret of
+// the round, revealed by the game over event.
+type StartedEvent struct {
+	Event       string `json:"event"`
+	Level       string `json:"level"`
+	MaxAttempts int    `json:"max_attempts"`
+	Remaining   int    `json:"remaining"`
+	Resumed     bool   `json:"resumed,omitempty"`
+	Commitment  string `json:"commitment,omitempty"`
+}
+
+// TurnResultEvent reports the outcome of a guess: "greater" or "less" when
+// the number is greater or less than the guess, "equal" when found, and
+// "mismatch" for a wrong code with its Bulls and Cows. In the multi variant,
+// "hit" finds a hidden number, and Above and Below count the numbers left on
+// each side of the guess. Hint is the key of the hint message, empty when
+// found.
+type TurnResultEvent struct {
+	Event      string `json:"event"`
+	Guess      int    `json:"guess"`
+	Outcome    string `json:"outcome"`
+	Difference int    `json:"difference"`
+	Bulls      int    `json:"bulls,omitempty"`
+	Cows       int    `json:"cows,omitempty"`
+	Above      int    `json:"above,omitempty"`
+	Below      int    `json:"below,omitempty"`
+	Hint       string `json:"hint,omitempty"`
+	Remaining  int    `json:"remaining"`
+}
+
+// ClueEvent reports an attempt spent on a clue telling whether the number is
+// a Multiple of Divisor.
+type ClueEvent struct {
+	Event     string `json:"event"`
+	Divisor   int    `json:"divisor"`
+	Multiple  bool   `json:"multiple"`
+	Remaining int    `json:"remaining"`
+}
+
+// ExpiredEvent reports an attempt wasted because the guess deadline passed
+// in time-attack mode.
+type ExpiredEvent struct {
+	Event     string `json:"event"`
+	Remaining int    `json:"remaining"`
+}
+
+// GameOverEvent reports the end of a round. Number is left out of lost
+// rounds that didn't reveal it, whose number is played again, and Secrets
+// holds every number of a lost multi round. TimedOut tells that the game
+// deadline passed. Secret and Nonce reveal the fairness commitment of the
+// started event.
+type GameOverEvent struct {
+	Event    string        `json:"event"`
+	Won      bool          `json:"won"`
+	Number   *int          `json:"number,omitempty"`
+	Secrets  []int         `json:"secrets,omitempty"`
+	Attempts int           `json:"attempts"`
+	Time     time.Duration `json:"time"`
+	TimedOut bool          `json:"timed_out,omitempty"`
+	Secret   string        `json:"secret,omitempty"`
+	Nonce    string        `json:"nonce,omitempty"`
+}
+
+// LiesEvent reveals the answers that were lies at the end of a round of the
+// lying-oracle mode, as the turns of the guesses, from 1.
+type LiesEvent struct {
+	Event   string `json:"event"`
+	Lies    []int  `json:"lies"`
+	MaxLies int    `json:"max_lies"`
+}
+
+// LeaderboardEvent carries the top scores after a win.
+type LeaderboardEvent struct {
+	Event  string       `json:"event"`
+	Scores store.Scores `json:"scores"`
+}
+
+// ErrorEvent reports an invalid request or input.
+type ErrorEvent struct {
+	Event   string `json:"event"`
+	Message string `json:"message"`
+}
+
+// Output writes one JSON event per line to the writer. It receives prompts,
+// errors and leaderboards from the service as a reporter, and turn results
+// and game overs by subscribing to the engine.
+type Output struct {
+	Writer io.Writer
+
+	pending *TurnResultEvent
+}
+
+// Prompt writes a prompt event for the input kind.
+func (o *Output) Prompt(input string) {
+	o.flush()
+	o.write(PromptEvent{Event: EventPrompt, Input: input})
+}
+
+// Leaderboard writes a leaderboard event with the scores.
+func (o *Output) Leaderboard(scores store.Scores) {
+	o.flush()
+	o.write(LeaderboardEvent{Event: EventLeaderboard, Scores: scores})
+}
+
+// Error writes an error event with the error message.
+func (o *Output) Error(err error) {
+	o.flush()
+	o.write(ErrorEvent{Event: EventError, Message: err.Error()})
+}
+
+// Notify writes an event for every engine event: a started event when the
+// round starts or resumes, a turn result event once the outcome of a guess
+// and its hint are known, clue and expired events for the attempts spent
+// otherwise, and game over and lies events at the end of the round.
+func (o *Output) Notify(event engine.Event) {
+	switch e := event.(type) {
+	case engine.GameStarted:
+		o.flush()
+		o.write(StartedEvent{
+			Event:       EventStarted,
+			Level:       e.Level,
+			MaxAttempts: e.MaxAttempts,
+			Remaining:   e.MaxAttempts,
+			Commitment:  e.Commitment,
+		})
+
+	case engine.GameResumed:
+		o.flush()
+		o.write(StartedEvent{
+			Event:       EventStarted,
+			Level:       e.Level,
+			MaxAttempts: e.MaxAttempts,
+			Remaining:   e.Remaining,
+			Resumed:     true,
+			Commitment:  e.Commitment,
+		})
+
+	case engine.GuessEvaluated:
+		o.flush()
+		o.pending = &TurnResultEvent{
+			Event:      EventTurnResult,
+			Guess:      e.Turn.GuessNumber,
+			Outcome:    e.Turn.Outcome.String(),
+			Difference: *e.Turn.Difference,
+			Bulls:      e.Turn.Bulls,
+			Cows:       e.Turn.Cows,
+			Above:      e.Turn.Above,
+			Below:      e.Turn.Below,
+			Remaining:  e.Remaining,
+		}
+
+	case engine.HintIssued:
+		if o.pending != nil {
+			o.pending.Hint = e.Key
+		}
+		o.flush()
+
+	case engine.ClueIssued:
+		o.flush()
+		o.write(ClueEvent{
+			Event:     EventClue,
+			Divisor:   e.Divisor,
+			Multiple:  e.Multiple,
+			Remaining: e.Remaining,
+		})
+
+	case engine.GuessExpired:
+		o.flush()
+		o.write(ExpiredEvent{Event: EventExpired, Remaining: e.Remaining})
+
+	case engine.GameWon:
+		o.flush()
+		o.write(GameOverEvent{
+			Event:    EventGameOver,
+			Won:      true,
+			Number:   &e.RandomNumber,
+			Attempts: e.Attempts,
+			Time:     e.Time,
+			Secret:   e.Secret,
+			Nonce:    e.Nonce,
+		})
+
+	case engine.GameLost:
+		var number *int
+		var secrets []int
+		if e.Revealed {
+			number, secrets = &e.RandomNumber, e.Secrets
+		}
+		o.flush()
+		o.write(GameOverEvent{
+			Event:    EventGameOver,
+			Won:      false,
+			Number:   number,
+			Secrets:  secrets,
+			Attempts: e.Attempts,
+			Time:     e.Time,
+			TimedOut: e.TimedOut,
+			Secret:   e.Secret,
+			Nonce:    e.Nonce,
+		})
+
+	case engine.LiesRevealed:
+		lies := []int{}
+		for i, turn := range e.Turns {
+			if turn.Lied {
+				lies = append(lies, i+1)
+			}
+		}
+		o.flush()
+		o.write(LiesEvent{Event: EventLies, Lies: lies, MaxLies: e.MaxLies})
+	}
+}
+
+func (o *Output) flush() {
+	if o.pending == nil {
+		return
+	}
+
+	o.write(*o.pending)
+	o.pending = nil
+}
+
+func (o *Output) write(event any) {
+	_ = json.NewEncoder(o.Writer).Encode(event)
+}
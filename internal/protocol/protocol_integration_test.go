@@ -0,0 +1,120 @@
+package protocol_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/config"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/protocol"
+	"github.com/go-number-guessing-game/internal/service"
+	"github.com/go-number-guessing-game/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationProtocolGame(t *testing.T) {
+	t.Run("play a game over JSON lines", func(t *testing.T) {
+		requests := strings.Join([]string{
+			`{"type": "player", "value": "bot"}`,
+			`{"type": "difficulty", "value": 3}`,
+			`{"type": "guess", "value": 48}`,
+			`{"type": "guess", "value": "oops"}`,
+			`{"type": "guess", "value": 50}`,
+			`{"type": "play_again", "value": 2}`,
+		}, "\n")
+
+		output := &bytes.Buffer{}
+		reporter := &protocol.Output{Writer: output}
+		game := service.Game{
+			Writer:      io.Discard,
+			InputSource: &protocol.Input{Source: strings.NewReader(requests)},
+			GameConfig:  config.LoadConfig("yaml", "../../configs/app.yaml"),
+			Reporter:    reporter,
+			Subscribers: []engine.Subscriber{reporter},
+		}
+		game.PlayGame(50, &store.ScoresStore{FilePath: t.TempDir() + "/s.json"})
+
+		var got []string
+		for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+			var event map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(line), &event))
+			got = append(got, event["event"].(string))
+		}
+
+		assert.Equal(t, []string{
+			protocol.EventPrompt,
+			protocol.EventPrompt,
+			protocol.EventStarted,
+			protocol.EventPrompt,
+			protocol.EventTurnResult,
+			protocol.EventPrompt,
+			protocol.EventError,
+			protocol.EventPrompt,
+			protocol.EventTurnResult,
+			protocol.EventGameOver,
+			protocol.EventLeaderboard,
+			protocol.EventPrompt,
+		}, got)
+	})
+
+	t.Run("commit to the number and reveal it", func(t *testing.T) {
+		requests := strings.Join([]string{
+			`{"type": "player", "value": "bot"}`,
+			`{"type": "difficulty", "value": 3}`,
+			`{"type": "guess", "value": 50}`,
+			`{"type": "play_again", "value": 2}`,
+		}, "\n")
+
+		output := &bytes.Buffer{}
+		reporter := &protocol.Output{Writer: output}
+		game := service.Game{
+			Writer:      io.Discard,
+			InputSource: &protocol.Input{Source: strings.NewReader(requests)},
+			GameConfig:  config.LoadConfig("yaml", "../../configs/app.yaml"),
+			Reporter:    reporter,
+			Subscribers: []engine.Subscriber{reporter},
+			Commit:      true,
+		}
+		game.PlayGame(50, &store.ScoresStore{FilePath: t.TempDir() + "/s.json"})
+
+		var started protocol.StartedEvent
+		var over protocol.GameOverEvent
+		for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+			switch {
+			case strings.Contains(line, `"event":"started"`):
+				assert.NoError(t, json.Unmarshal([]byte(line), &started))
+			case strings.Contains(line, `"event":"game_over"`):
+				assert.NoError(t, json.Unmarshal([]byte(line), &over))
+			}
+		}
+
+		assert.NotEmpty(t, started.Commitment)
+		assert.Equal(t, "50", over.Secret)
+		assert.True(t, fairness.Verify(started.Commitment, over.Secret, over.Nonce))
+	})
+
+	t.Run("stop when the input ends", func(t *testing.T) {
+		requests := `{"type": "player", "value": "bot"}`
+
+		output := &bytes.Buffer{}
+		reporter := &protocol.Output{Writer: output}
+		game := service.Game{
+			Writer:      io.Discard,
+			InputSource: &protocol.Input{Source: strings.NewReader(requests)},
+			GameConfig:  config.LoadConfig("yaml", "../../configs/app.yaml"),
+			Reporter:    reporter,
+			Subscribers: []engine.Subscriber{reporter},
+		}
+		game.PlayGame(50, &store.ScoresStore{FilePath: t.TempDir() + "/s.json"})
+
+		assert.Equal(t,
+			`{"event":"prompt","input":"player"}`+"\n"+
+				`{"event":"prompt","input":"difficulty"}`+"\n",
+			output.String(),
+		)
+	})
+}
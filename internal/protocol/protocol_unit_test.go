@@ -0,0 +1,322 @@
+package protocol_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/parser"
+	"github.com/go-number-guessing-game/internal/protocol"
+	"github.com/go-number-guessing-game/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitInput(t *testing.T) {
+	t.Run("return request values", func(t *testing.T) {
+		input := &protocol.Input{Source: strings.NewReader(strings.Join([]string{
+			`{"type": "player", "value": "bot"}`,
+			`{"type": "difficulty", "value": 3}`,
+			`{"type": "guess", "value": ":range"}`,
+			`{"type": "play_again", "value": "2"}`,
+		}, "\n"))}
+
+		player, err := input.NextPlayerInput()
+		assert.NoError(t, err)
+		assert.Equal(t, "bot", player)
+
+		difficulty, err := input.NextDifficultyInput()
+		assert.NoError(t, err)
+		assert.Equal(t, "3", difficulty)
+
+		guess, err := input.NextGuessNumberInput()
+		assert.NoError(t, err)
+		assert.Equal(t, ":range", guess)
+
+		playAgain, err := input.NextPlayAgainInput()
+		assert.NoError(t, err)
+		assert.Equal(t, "2", playAgain)
+
+		_, err = input.NextPlayerInput()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("error when unexpected request type", func(t *testing.T) {
+		input := &protocol.Input{
+			Source: strings.NewReader(`{"type": "guess", "value": 50}`),
+		}
+
+		want := protocol.NewRequestTypeError("player", "guess")
+		_, got := input.NextPlayerInput()
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+
+		kind, value := want.(*protocol.RequestTypeError).Answer()
+		assert.Equal(t, "guess", kind)
+		assert.Equal(t, "50", value)
+	})
+
+	t.Run("error when invalid JSON", func(t *testing.T) {
+		input := &protocol.Input{Source: strings.NewReader("50")}
+
+		want := protocol.NewRequestFormatError(nil)
+		_, got := input.NextGuessNumberInput()
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+	})
+}
+
+func TestUnitOutput(t *testing.T) {
+	t.Run("write one event per line", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			write       func(output *protocol.Output)
+			want        string
+		}{
+			{
+				description: "prompt",
+				write: func(output *protocol.Output) {
+					output.Prompt("guess")
+				},
+				want: `{"event":"prompt","input":"guess"}`,
+			},
+			{
+				description: "started with a commitment",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GameStarted{
+						Level:       "Hard",
+						MaxAttempts: 3,
+						Commitment:  "abc",
+					})
+				},
+				want: `{"event":"started","level":"Hard","max_attempts":3,` +
+					`"remaining":3,"commitment":"abc"}`,
+			},
+			{
+				description: "resumed",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GameResumed{
+						Level:       "Hard",
+						MaxAttempts: 3,
+						Remaining:   1,
+					})
+				},
+				want: `{"event":"started","level":"Hard","max_attempts":3,` +
+					`"remaining":1,"resumed":true}`,
+			},
+			{
+				description: "clue",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.ClueIssued{
+						Divisor:   3,
+						Multiple:  true,
+						Remaining: 2,
+					})
+				},
+				want: `{"event":"clue","divisor":3,"multiple":true,"remaining":2}`,
+			},
+			{
+				description: "turn result with hint",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GuessEvaluated{
+						Turn: game.Turn{
+							GuessNumber: 48,
+							Outcome:     toOutcome(game.Greater),
+							Difference:  toPointer(2),
+						},
+						Remaining: 2,
+					})
+					output.Notify(engine.HintIssued{Key: "very_close_2"})
+				},
+				want: `{"event":"turn_result","guess":48,"outcome":"greater",` +
+					`"difference":2,"hint":"very_close_2","remaining":2}`,
+			},
+			{
+				description: "turn result flushed by the next prompt",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GuessEvaluated{
+						Turn: game.Turn{
+							GuessNumber: 48,
+							Outcome:     toOutcome(game.Greater),
+							Difference:  toPointer(2),
+						},
+						Remaining: 2,
+					})
+					output.Prompt("guess")
+				},
+				want: `{"event":"turn_result","guess":48,"outcome":"greater",` +
+					`"difference":2,"remaining":2}` + "\n" +
+					`{"event":"prompt","input":"guess"}`,
+			},
+			{
+				description: "game over keeping the number",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GameLost{
+						RandomNumber: 50,
+						Attempts:     3,
+						Time:         time.Second,
+					})
+				},
+				want: `{"event":"game_over","won":false,` +
+					`"attempts":3,"time":1000000000}`,
+			},
+			{
+				description: "game over revealing the number",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GameLost{
+						RandomNumber: 50,
+						Attempts:     2,
+						Time:         time.Second,
+						GaveUp:       true,
+						Revealed:     true,
+					})
+				},
+				want: `{"event":"game_over","won":false,"number":50,` +
+					`"attempts":2,"time":1000000000}`,
+			},
+			{
+				description: "game over revealing the commitment",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GameWon{
+						RandomNumber: 50,
+						Attempts:     2,
+						Time:         time.Second,
+						Secret:       "50",
+						Nonce:        "def",
+					})
+				},
+				want: `{"event":"game_over","won":true,"number":50,` +
+					`"attempts":2,"time":1000000000,"secret":"50","nonce":"def"}`,
+			},
+			{
+				description: "game over revealing the numbers of the multi variant",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GameLost{
+						RandomNumber: 50,
+						Secrets:      []int{50, 7},
+						Attempts:     3,
+						Time:         time.Second,
+						Revealed:     true,
+					})
+				},
+				want: `{"event":"game_over","won":false,"number":50,` +
+					`"secrets":[50,7],"attempts":3,"time":1000000000}`,
+			},
+			{
+				description: "lies",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.LiesRevealed{
+						Turns:   game.Turns{{GuessNumber: 10}, {GuessNumber: 20, Lied: true}},
+						MaxLies: 1,
+					})
+				},
+				want: `{"event":"lies","lies":[2],"max_lies":1}`,
+			},
+			{
+				description: "expired guess and timed out game",
+				write: func(output *protocol.Output) {
+					output.Notify(engine.GuessExpired{Remaining: 2})
+					output.Notify(engine.GameLost{
+						RandomNumber: 50,
+						Attempts:     1,
+						Time:         time.Second,
+						TimedOut:     true,
+						Revealed:     true,
+					})
+				},
+				want: `{"event":"expired","remaining":2}` + "\n" +
+					`{"event":"game_over","won":false,"number":50,` +
+					`"attempts":1,"time":1000000000,"timed_out":true}`,
+			},
+			{
+				description: "leaderboard",
+				write: func(output *protocol.Output) {
+					output.Leaderboard(store.Scores{{
+						Player:   "bot",
+						Level:    "Hard",
+						Attempts: 2,
+						Time:     time.Second,
+					}})
+				},
+				want: `{"event":"leaderboard","scores":[{"player":"bot",` +
+					`"level":"Hard","attempts":2,"time":1000000000}]}`,
+			},
+			{
+				description: "error",
+				write: func(output *protocol.Output) {
+					output.Error(parser.NewParseNumberError())
+				},
+				want: `{"event":"error","message":"It must be an integer."}`,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				buffer := &bytes.Buffer{}
+				tc.write(&protocol.Output{Writer: buffer})
+
+				assert.Equal(t, tc.want+"\n", buffer.String())
+			})
+		}
+	})
+}
+
+func TestUnitOutputEvents(t *testing.T) {
+	t.Run("write a documented event for every engine event", func(t *testing.T) {
+		turn := game.Turn{
+			GuessNumber: 48,
+			Outcome:     toOutcome(game.Greater),
+			Difference:  toPointer(2),
+		}
+		events := []engine.Event{
+			engine.GameStarted{},
+			engine.GameResumed{},
+			engine.GuessEvaluated{Turn: turn},
+			engine.HintIssued{Key: "very_close_2"},
+			engine.ClueIssued{Divisor: 2},
+			engine.GuessExpired{},
+			engine.GameWon{},
+			engine.GameLost{},
+			engine.LiesRevealed{},
+		}
+		documented := []string{
+			protocol.EventStarted,
+			protocol.EventStarted,
+			protocol.EventTurnResult,
+			protocol.EventClue,
+			protocol.EventExpired,
+			protocol.EventGameOver,
+			protocol.EventGameOver,
+			protocol.EventLies,
+		}
+
+		buffer := &bytes.Buffer{}
+		output := &protocol.Output{Writer: buffer}
+		for _, event := range events {
+			output.Notify(event)
+		}
+
+		var got []string
+		for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+			var event map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(line), &event))
+			got = append(got, event["event"].(string))
+		}
+		assert.Equal(t, documented, got)
+	})
+}
+
+func toPointer(value int) *int {
+	return &value
+}
+
+func toOutcome(value game.Outcome) *game.Outcome {
+	return &value
+}
@@ -0,0 +1,310 @@
+// Package rating keeps an Elo-style skill rating per player, updated after
+// every round from its difficulty and how the attempts compare with the
+// optimal number of guesses for the range.
+package rating
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/timer"
+	"github.com/olekukonko/tablewriter"
+)
+
+// NoRatings is the message displayed when no player is rated yet. It is
+// public for testing purposes.
+const NoRatings = "No ratings yet. Please play a game to get rated."
+
+// System rates rounds as Elo matches against the level, whose rating is
+// the strength of its opponent. Players start at Initial, and K bounds the
+// change of a single round.
+type System struct {
+	Initial float64
+	K       float64
+	Levels  map[string]float64
+}
+
+// DefaultSystem rates players from 1200, against Easy at 1000, Medium at
+// 1200, Hard at 1400, and Adaptive at the initial rating since it is tuned
+// to the player.
+var DefaultSystem = System{
+	Initial: 1200,
+	K:       32,
+	Levels: map[string]float64{
+		"Easy":     1000,
+		"Medium":   1200,
+		"Hard":     1400,
+		"Adaptive": 1200,
+	},
+}
+
+// RangePerformance returns the score of a round played with a number
+// between low and high: 0 when lost, and when won, the optimal number of
+// guesses for the range over the attempts, capped at 1 for rounds won in
+// fewer attempts than a binary search needs.
+func RangePerformance(won bool, attempts, low, high int) float64 {
+	if !won || attempts <= 0 {
+		return 0
+	}
+
+	optimal := game.OptimalGuesses(low, high)
+	return math.Min(1, float64(optimal)/float64(attempts))
+}
+
+// Expected returns the expected score of a player rated current against
+// the level.
+func (s System) Expected(current float64, level string) float64 {
+	opponent, ok := s.Levels[level]
+	if !ok {
+		opponent = s.Initial
+	}
+
+	return 1 / (1 + math.Pow(10, (opponent-current)/400))
+}
+
+// Rate returns the new rating of a player rated current after a round at
+// the level with the performance.
+func (s System) Rate(current float64, level string, performance float64) float64 {
+	return current + s.K*(performance-s.Expected(current, level))
+}
+
+// Entry is a rated round in the history of a player, played under the
+// name Player.
+type Entry struct {
+	Player      string    `json:"player,omitempty"`
+	Date        time.Time `json:"date"`
+	Level       string    `json:"level"`
+	Won         bool      `json:"won"`
+	Attempts    int       `json:"attempts"`
+	Performance float64   `json:"performance"`
+	Rating      float64   `json:"rating"`
+	Change      float64   `json:"change"`
+}
+
+// History lists the rated rounds of a player, oldest first.
+type History []Entry
+
+// String formats the history as an ASCII table.
+func (h History) String() string {
+	if len(h) == 0 {
+		return NoRatings
+	}
+
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Date", "Level", "Result", "Attempts", "Rating", "Change"})
+
+	for _, entry := range h {
+		result := "Lost"
+		if entry.Won {
+			result = "Won"
+		}
+
+		table.Append([]string{
+			entry.Date.Format(time.DateOnly),
+			entry.Level,
+			result,
+			strconv.Itoa(entry.Attempts),
+			strconv.Itoa(int(math.Round(entry.Rating))),
+			fmt.Sprintf("%+d", int(math.Round(entry.Change))),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Ratings holds the rating history of every player, by the profile key of
+// the player.
+type Ratings map[string]History
+
+// Rating returns the current rating of the player, or the initial rating of
+// the system when the player has no history.
+func (r Ratings) Rating(system System, player string) float64 {
+	history := r[player]
+	if len(history) == 0 {
+		return system.Initial
+	}
+
+	return history[len(history)-1].Rating
+}
+
+// Standing is a player's place on the ratings leaderboard.
+type Standing struct {
+	Player string
+	Rating float64
+	Games  int
+}
+
+// Standings is the ratings leaderboard, best rated first.
+type Standings []Standing
+
+// Standings returns the leaderboard of the current ratings, sorted by
+// rating, then by name, each player shown by the name of the last round.
+func (r Ratings) Standings() Standings {
+	standings := Standings{}
+	for player, history := range r {
+		if len(history) == 0 {
+			continue
+		}
+
+		if name := history[len(history)-1].Player; name != "" {
+			player = name
+		}
+		standings = append(standings, Standing{
+			Player: player,
+			Rating: history[len(history)-1].Rating,
+			Games:  len(history),
+		})
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Rating != standings[j].Rating {
+			return standings[i].Rating > standings[j].Rating
+		}
+		return standings[i].Player < standings[j].Player
+	})
+
+	return standings
+}
+
+// String formats the standings as an ASCII table.
+func (s Standings) String() string {
+	if len(s) == 0 {
+		return NoRatings
+	}
+
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Rank", "Player", "Rating", "Games"})
+
+	for i, standing := range s {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			standing.Player,
+			strconv.Itoa(int(math.Round(standing.Rating))),
+			strconv.Itoa(standing.Games),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Store defines methods for loading ratings and adding a rated round,
+// facilitating testing.
+type Store interface {
+	Load() Ratings
+	Add(player string, entry Entry) (Ratings, error)
+}
+
+// Recorder subscribes to the game engine to rate the player after every
+// round with the system, under the profile key of the player and ProfileID.
+// Only rounds of the number variant are rated, since the performance
+// compares their attempts with a binary search. Timer dates the rounds,
+// defaulting to the local time. Entry holds the rated round, zero when the
+// round isn't rated, and Err the error storing it.
+type Recorder struct {
+	Store     Store
+	System    System
+	Timer     timer.Timer
+	ProfileID int
+	Entry     Entry
+	Err       error
+}
+
+// Notify rates the player when the event ends a round of the number
+// variant.
+func (r *Recorder) Notify(event engine.Event) {
+	switch e := event.(type) {
+	case engine.GameWon:
+		if e.Variant != "" {
+			return
+		}
+		bounds := game.GameState{Min: e.Min, Max: e.Max}
+		low, high := bounds.Bounds()
+		performance := RangePerformance(true, e.Attempts, low, high)
+		r.rate(e.Player, e.Level, true, e.Attempts, performance)
+	case engine.GameLost:
+		if e.Variant != "" {
+			return
+		}
+		r.rate(e.Player, e.Level, false, e.Attempts, 0)
+	}
+}
+
+func (r *Recorder) rate(
+	player, level string,
+	won bool,
+	attempts int,
+	performance float64,
+) {
+	clock := r.Timer
+	if clock == nil {
+		clock = &timer.DefaultTimer{}
+	}
+
+	key := profile.Key(player, r.ProfileID)
+	current := r.Store.Load().Rating(r.System, key)
+	rating := r.System.Rate(current, level, performance)
+
+	r.Entry = Entry{
+		Player:      player,
+		Date:        clock.Now(),
+		Level:       level,
+		Won:         won,
+		Attempts:    attempts,
+		Performance: performance,
+		Rating:      rating,
+		Change:      rating - current,
+	}
+	_, r.Err = r.Store.Add(key, r.Entry)
+}
+
+// FileStore manages the file path of the ratings, which must be a JSON
+// file.
+type FileStore struct {
+	FilePath string
+}
+
+// Load retrieves the ratings. If no ratings exist, it returns empty Ratings.
+func (s *FileStore) Load() Ratings {
+	byt, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return Ratings{}
+	}
+
+	var ratings Ratings
+	if err = json.Unmarshal(byt, &ratings); err != nil || ratings == nil {
+		return Ratings{}
+	}
+
+	return ratings
+}
+
+// Add appends the rated round to the history of the player, returning the
+// updated ratings.
+func (s *FileStore) Add(player string, entry Entry) (Ratings, error) {
+	ratings := s.Load()
+	ratings[player] = append(ratings[player], entry)
+
+	byt, err := json.Marshal(ratings)
+	if err != nil {
+		return Ratings{}, err
+	}
+
+	if err = os.WriteFile(s.FilePath, byt, 0o644); err != nil {
+		return Ratings{}, err
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,205 @@
+package rating_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/rating"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationPerformance(t *testing.T) {
+	t.Run("compare the attempts with the optimal guesses", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			won         bool
+			attempts    int
+			want        float64
+		}{
+			{description: "lost", won: false, attempts: 3, want: 0},
+			{description: "optimal", won: true, attempts: 7, want: 1},
+			{description: "lucky", won: true, attempts: 2, want: 1},
+			{description: "slow", won: true, attempts: 10, want: 0.7},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got := rating.RangePerformance(tc.won, tc.attempts,
+					game.MinNumber, game.MaxNumber)
+
+				assert.InDelta(t, tc.want, got, 0.001)
+			})
+		}
+	})
+
+	t.Run("compare with the optimal guesses of the range", func(t *testing.T) {
+		assert.InDelta(t, 1, rating.RangePerformance(true, 8, 1, 200), 0.001)
+		assert.InDelta(t, 0.5, rating.RangePerformance(true, 8, 1, 10), 0.001)
+	})
+}
+
+func TestIntegrationSystemRate(t *testing.T) {
+	system := rating.DefaultSystem
+
+	t.Run("gain more for a harder level", func(t *testing.T) {
+		easy := system.Rate(1200, "Easy", 1)
+		hard := system.Rate(1200, "Hard", 1)
+
+		assert.Greater(t, easy, 1200.0)
+		assert.Greater(t, hard, easy)
+		assert.InDelta(t, 1224.3, hard, 0.1)
+	})
+
+	t.Run("lose more for an easier level", func(t *testing.T) {
+		easy := system.Rate(1200, "Easy", 0)
+		hard := system.Rate(1200, "Hard", 0)
+
+		assert.Less(t, easy, hard)
+		assert.Less(t, hard, 1200.0)
+	})
+
+	t.Run("expect an even score against the same rating", func(t *testing.T) {
+		assert.InDelta(t, 0.5, system.Expected(1200, "Medium"), 0.001)
+	})
+}
+
+func TestIntegrationRecorder(t *testing.T) {
+	t.Run("rate the player after every round", func(t *testing.T) {
+		fileStore := &rating.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "ratings.json"),
+		}
+		recorder := &rating.Recorder{
+			Store:  fileStore,
+			System: rating.DefaultSystem,
+			Timer:  &StubTimer{},
+		}
+
+		recorder.Notify(engine.GameWon{Player: "test", Level: "Hard", Attempts: 3})
+		assert.NoError(t, recorder.Err)
+		assert.InDelta(t, 24.3, recorder.Entry.Change, 0.1)
+
+		recorder.Notify(engine.GameLost{Player: "test", Level: "Easy", Attempts: 10})
+		assert.NoError(t, recorder.Err)
+		assert.Less(t, recorder.Entry.Change, 0.0)
+
+		history := fileStore.Load()["test"]
+		assert.Len(t, history, 2)
+		assert.Equal(t, recorder.Entry, history[1])
+		assert.Equal(t, stubDate, history[0].Date)
+		assert.Equal(t, history[1].Rating, fileStore.Load().Rating(rating.DefaultSystem, "test"))
+	})
+
+	t.Run("rate the player by profile across names", func(t *testing.T) {
+		fileStore := &rating.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "ratings.json"),
+		}
+		recorder := &rating.Recorder{
+			Store:     fileStore,
+			System:    rating.DefaultSystem,
+			Timer:     &StubTimer{},
+			ProfileID: 2,
+		}
+
+		recorder.Notify(engine.GameWon{Player: "Bob", Level: "Hard", Attempts: 3})
+		recorder.Notify(engine.GameWon{Player: "Robert", Level: "Hard", Attempts: 3})
+		assert.NoError(t, recorder.Err)
+
+		ratings := fileStore.Load()
+		assert.Len(t, ratings["profile:2"], 2)
+		assert.Equal(t, "Robert", ratings["profile:2"][1].Player)
+		assert.Equal(t, "Robert", ratings.Standings()[0].Player)
+		assert.Equal(t, 2, ratings.Standings()[0].Games)
+	})
+
+	t.Run("leave the rounds of other variants unrated", func(t *testing.T) {
+		fileStore := &rating.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "ratings.json"),
+		}
+		recorder := &rating.Recorder{Store: fileStore, System: rating.DefaultSystem}
+
+		recorder.Notify(engine.GameWon{
+			Player:   "test",
+			Level:    "Hard",
+			Variant:  "code",
+			Min:      1000,
+			Max:      9999,
+			Attempts: 14,
+		})
+		recorder.Notify(engine.GameLost{Player: "test", Level: "Hard", Variant: "multi"})
+
+		assert.NoError(t, recorder.Err)
+		assert.Equal(t, rating.Entry{}, recorder.Entry)
+		assert.Equal(t, rating.Ratings{}, fileStore.Load())
+	})
+
+	t.Run("ignore other events", func(t *testing.T) {
+		fileStore := &rating.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "ratings.json"),
+		}
+		recorder := &rating.Recorder{Store: fileStore, System: rating.DefaultSystem}
+
+		recorder.Notify(engine.GameStarted{Player: "test"})
+
+		assert.Equal(t, rating.Ratings{}, fileStore.Load())
+	})
+}
+
+func TestIntegrationRatingsStandings(t *testing.T) {
+	t.Run("rank players by current rating", func(t *testing.T) {
+		ratings := rating.Ratings{
+			"first":  {{Rating: 1180}, {Rating: 1250}},
+			"second": {{Rating: 1300}},
+			"third":  {{Rating: 1210}},
+		}
+
+		want := rating.Standings{
+			{Player: "second", Rating: 1300, Games: 1},
+			{Player: "first", Rating: 1250, Games: 2},
+			{Player: "third", Rating: 1210, Games: 1},
+		}
+
+		assert.Equal(t, want, ratings.Standings())
+		assert.Contains(t, ratings.Standings().String(), "1250")
+	})
+
+	t.Run("return message to user when no ratings", func(t *testing.T) {
+		assert.Equal(t, rating.NoRatings, rating.Ratings{}.Standings().String())
+		assert.Equal(t, rating.NoRatings, rating.History{}.String())
+	})
+
+	t.Run("start unrated players at the initial rating", func(t *testing.T) {
+		got := rating.Ratings{}.Rating(rating.DefaultSystem, "new")
+
+		assert.Equal(t, rating.DefaultSystem.Initial, got)
+	})
+}
+
+func TestIntegrationHistoryString(t *testing.T) {
+	t.Run("return the rated rounds as a table", func(t *testing.T) {
+		history := rating.History{{
+			Date:     stubDate,
+			Level:    "Hard",
+			Won:      true,
+			Attempts: 3,
+			Rating:   1224.3,
+			Change:   24.3,
+		}}
+
+		got := history.String()
+
+		for _, want := range []string{"2001-01-01", "Hard", "Won", "1224", "+24"} {
+			assert.Contains(t, got, want)
+		}
+	})
+}
+
+var stubDate = time.Date(2001, 1, 1, 1, 1, 0, 0, time.UTC)
+
+type StubTimer struct{}
+
+func (s *StubTimer) Now() time.Time {
+	return stubDate
+}
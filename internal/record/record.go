@@ -0,0 +1,311 @@
+// Package record keeps every finished round turn by turn in a JSON file, so
+// that past games can be replayed and reviewed.
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/game"
+)
+
+// GameNotFoundError represents an error occurring when no recorded game has
+// the requested ID.
+type GameNotFoundError struct {
+	ID int
+}
+
+// GameNotFoundMessage is the message displayed when no recorded game has the
+// ID. It is public for testing purposes.
+const GameNotFoundMessage = "No recorded game with ID %d."
+
+// Error returns the error message for GameNotFoundError.
+func (e *GameNotFoundError) Error() string {
+	return fmt.Sprintf(GameNotFoundMessage, e.ID)
+}
+
+// NewGameNotFoundError creates a new GameNotFoundError for testing.
+func NewGameNotFoundError(id int) error {
+	return &GameNotFoundError{ID: id}
+}
+
+// The actions of a recorded step.
+const (
+	ActionGuess   = "guess"
+	ActionHint    = "hint"
+	ActionGiveUp  = "give_up"
+	ActionExpire  = "expire"
+	ActionTimeOut = "time_out"
+)
+
+// Step is one action of a recorded round, with the time elapsed in the
+// round when it was played. Number is the guessed number of a guess, and Lie
+// is set when its answer was a lie.
+type Step struct {
+	Action  string        `json:"action"`
+	Number  int           `json:"number,omitempty"`
+	Lie     bool          `json:"lie,omitempty"`
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+// Command returns the engine command playing the step again.
+func (s Step) Command() engine.Command {
+	switch s.Action {
+	case ActionHint:
+		return engine.SpendHint{}
+	case ActionGiveUp:
+		return engine.GiveUp{}
+	case ActionExpire:
+		return engine.GuessTimeout{}
+	case ActionTimeOut:
+		return engine.GameTimeout{}
+	default:
+		return engine.Guess{Number: s.Number, Lie: s.Lie}
+	}
+}
+
+// Game is a finished round with every step played, identified by its ID in
+// the store. Min and Max are only set for rounds played outside the default
+// range, MaxLies for rounds allowing lies, and Variant for rounds of another
+// variant than the number one, with Secrets for the multi variant and Seed
+// for the drift variant. Commitment is the fairness commitment shown when
+// the round started, for rounds committed to.
+type Game struct {
+	ID           int                  `json:"id"`
+	Player       string               `json:"player"`
+	Level        string               `json:"level"`
+	MaxAttempts  int                  `json:"max_attempts"`
+	RandomNumber int                  `json:"random_number"`
+	Min          int                  `json:"min,omitempty"`
+	Max          int                  `json:"max,omitempty"`
+	MaxLies      int                  `json:"max_lies,omitempty"`
+	Variant      string               `json:"variant,omitempty"`
+	Secrets      []int                `json:"secrets,omitempty"`
+	Seed         uint64               `json:"seed,omitempty"`
+	Commitment   *fairness.Commitment `json:"commitment,omitempty"`
+	Steps        []Step               `json:"steps"`
+	Won          bool                 `json:"won"`
+	Time         time.Duration        `json:"time"`
+}
+
+// Start returns the engine command starting the round again.
+func (g Game) Start() engine.Start {
+	return engine.Start{
+		Player:       g.Player,
+		Level:        g.Level,
+		MaxAttempts:  g.MaxAttempts,
+		RandomNumber: g.RandomNumber,
+		Min:          g.Min,
+		Max:          g.Max,
+		MaxLies:      g.MaxLies,
+		Variant:      g.Variant,
+		Secrets:      g.Secrets,
+		Seed:         g.Seed,
+		Commitment:   g.commitment(),
+	}
+}
+
+// State returns the game state the round started from, whose secret the
+// commitment was made to.
+func (g Game) State() game.GameState {
+	return game.GameState{
+		RandomNumber: g.RandomNumber,
+		Variant:      g.Variant,
+		Secrets:      g.Secrets,
+		Seed:         g.Seed,
+	}
+}
+
+// Verify reports whether the commitment of the game was made to its secret.
+func (g Game) Verify() bool {
+	state := g.State()
+	return g.commitment().Verify(state.Secret())
+}
+
+func (g Game) commitment() fairness.Commitment {
+	if g.Commitment == nil {
+		return fairness.Commitment{}
+	}
+	return *g.Commitment
+}
+
+// Attempts returns the attempts played in the round: its guesses and the
+// guesses that expired.
+func (g Game) Attempts() int {
+	attempts := 0
+	for _, step := range g.Steps {
+		if step.Action == ActionGuess || step.Action == ActionExpire {
+			attempts++
+		}
+	}
+	return attempts
+}
+
+// Games is a collection of recorded games, in the order they were played.
+type Games []Game
+
+// Find returns the game with the ID. It returns a GameNotFoundError when no
+// game has it.
+func (g Games) Find(id int) (Game, error) {
+	for _, game := range g {
+		if game.ID == id {
+			return game, nil
+		}
+	}
+
+	return Game{}, NewGameNotFoundError(id)
+}
+
+// Store defines methods for loading and adding recorded games, facilitating
+// testing.
+type Store interface {
+	Load() Games
+	Add(game Game) (Game, error)
+}
+
+// Recorder subscribes to the game engine to record the steps of a round,
+// adding the game to the store once the round is over. Engine is the
+// subscribed engine, read for the number and the time of each step. Game
+// holds the added game, with its ID, and Err the error adding it.
+type Recorder struct {
+	Store  Store
+	Engine *engine.Engine
+	Game   Game
+	Err    error
+}
+
+// Notify records the step of the event, and adds the game at the end of the
+// round.
+func (r *Recorder) Notify(event engine.Event) {
+	switch e := event.(type) {
+	case engine.GameStarted:
+		r.begin()
+
+	case engine.GameResumed:
+		// The times of the steps played before the suspension aren't saved,
+		// so they all take the time of the suspension.
+		r.begin()
+		turns := e.Turns
+		for _, step := range e.Steps {
+			switch step {
+			case engine.StepGuess:
+				r.guess(turns[0], e.Elapsed)
+				turns = turns[1:]
+			case engine.StepHint:
+				r.step(ActionHint, 0, e.Elapsed)
+			case engine.StepExpire:
+				r.step(ActionExpire, 0, e.Elapsed)
+			}
+		}
+
+	case engine.GuessEvaluated:
+		r.guess(e.Turn, r.Engine.Elapsed())
+
+	case engine.ClueIssued:
+		r.step(ActionHint, 0, r.Engine.Elapsed())
+
+	case engine.GuessExpired:
+		r.step(ActionExpire, 0, r.Engine.Elapsed())
+
+	case engine.GameWon:
+		r.Game.Won = true
+		r.Game.Time = e.Time
+		r.Game, r.Err = r.Store.Add(r.Game)
+
+	case engine.GameLost:
+		switch {
+		case e.GaveUp:
+			r.step(ActionGiveUp, 0, e.Time)
+		case e.TimedOut:
+			r.step(ActionTimeOut, 0, e.Time)
+		}
+		r.Game.Time = e.Time
+		r.Game, r.Err = r.Store.Add(r.Game)
+	}
+}
+
+func (r *Recorder) begin() {
+	gameState := r.Engine.State()
+	r.Game = Game{
+		Player:       r.Engine.Player(),
+		Level:        gameState.Level,
+		MaxAttempts:  gameState.MaxAttempts,
+		RandomNumber: gameState.RandomNumber,
+		Min:          gameState.Min,
+		Max:          gameState.Max,
+		MaxLies:      gameState.MaxLies,
+		Variant:      gameState.Variant,
+		Secrets:      gameState.Secrets,
+		Seed:         gameState.Seed,
+		Steps:        []Step{},
+	}
+	if commitment := r.Engine.Commitment(); commitment.Hash != "" {
+		r.Game.Commitment = &commitment
+	}
+	r.Err = nil
+}
+
+func (r *Recorder) guess(turn game.Turn, elapsed time.Duration) {
+	r.Game.Steps = append(r.Game.Steps, Step{
+		Action:  ActionGuess,
+		Number:  turn.GuessNumber,
+		Lie:     turn.Lied,
+		Elapsed: elapsed,
+	})
+}
+
+func (r *Recorder) step(action string, number int, elapsed time.Duration) {
+	r.Game.Steps = append(r.Game.Steps, Step{
+		Action:  action,
+		Number:  number,
+		Elapsed: elapsed,
+	})
+}
+
+// FileStore manages the file path of the recorded games, which must be a
+// JSON file.
+type FileStore struct {
+	FilePath string
+}
+
+// Load retrieves the recorded games. If no games exist, it returns an empty
+// Games collection.
+func (s *FileStore) Load() Games {
+	byt, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return Games{}
+	}
+
+	var games Games
+	if err = json.Unmarshal(byt, &games); err != nil {
+		return Games{}
+	}
+
+	return games
+}
+
+// Add appends the game with the next ID, returning the game with its ID.
+func (s *FileStore) Add(game Game) (Game, error) {
+	games := s.Load()
+
+	game.ID = 1
+	if len(games) > 0 {
+		game.ID = games[len(games)-1].ID + 1
+	}
+	games = append(games, game)
+
+	byt, err := json.Marshal(games)
+	if err != nil {
+		return Game{}, err
+	}
+
+	if err = os.WriteFile(s.FilePath, byt, 0o644); err != nil {
+		return Game{}, err
+	}
+
+	return game, nil
+}
@@ -0,0 +1,211 @@
+package record_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationRecorder(t *testing.T) {
+	t.Run("record the steps of a finished round", func(t *testing.T) {
+		fileStore := &record.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "games.json"),
+		}
+		round := &engine.Engine{Timer: &StubTimer{}}
+		recorder := &record.Recorder{Store: fileStore, Engine: round}
+		round.Subscribe(recorder)
+
+		assert.NoError(t, round.Handle(engine.Start{
+			Player:       "test",
+			Level:        "Medium",
+			MaxAttempts:  5,
+			RandomNumber: 50,
+		}))
+		for _, command := range []engine.Command{
+			engine.Guess{Number: 25},
+			engine.SpendHint{},
+			engine.GiveUp{},
+		} {
+			assert.NoError(t, round.Handle(command))
+		}
+
+		want := record.Game{
+			ID:           1,
+			Player:       "test",
+			Level:        "Medium",
+			MaxAttempts:  5,
+			RandomNumber: 50,
+			Steps: []record.Step{
+				{Action: record.ActionGuess, Number: 25, Elapsed: 10 * time.Second},
+				{Action: record.ActionHint, Elapsed: 10 * time.Second},
+				{Action: record.ActionGiveUp, Elapsed: 10 * time.Second},
+			},
+			Time: 10 * time.Second,
+		}
+
+		assert.NoError(t, recorder.Err)
+		assert.Equal(t, want, recorder.Game)
+		assert.Equal(t, record.Games{want}, fileStore.Load())
+	})
+
+	t.Run("record the steps of a resumed round in order", func(t *testing.T) {
+		fileStore := &record.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "games.json"),
+		}
+		round := &engine.Engine{Timer: &StubTimer{}}
+		recorder := &record.Recorder{Store: fileStore, Engine: round}
+		round.Subscribe(recorder)
+
+		assert.NoError(t, round.Handle(engine.Resume{
+			Player:       "test",
+			Level:        "Medium",
+			MaxAttempts:  5,
+			RandomNumber: 50,
+			Steps: []string{
+				engine.StepGuess, engine.StepHint, engine.StepExpire, engine.StepGuess,
+			},
+			Guesses: []int{25, 75},
+			Elapsed: 5 * time.Second,
+		}))
+		assert.NoError(t, round.Handle(engine.GiveUp{}))
+
+		want := []record.Step{
+			{Action: record.ActionGuess, Number: 25, Elapsed: 5 * time.Second},
+			{Action: record.ActionHint, Elapsed: 5 * time.Second},
+			{Action: record.ActionExpire, Elapsed: 5 * time.Second},
+			{Action: record.ActionGuess, Number: 75, Elapsed: 5 * time.Second},
+			{Action: record.ActionGiveUp, Elapsed: 15 * time.Second},
+		}
+
+		assert.NoError(t, recorder.Err)
+		assert.Equal(t, want, recorder.Game.Steps)
+	})
+}
+
+func TestIntegrationFileStore(t *testing.T) {
+	t.Run("add games with increasing IDs", func(t *testing.T) {
+		fileStore := &record.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "games.json"),
+		}
+
+		for i := 1; i <= 3; i++ {
+			got, err := fileStore.Add(record.Game{Player: "test", Won: true})
+
+			assert.NoError(t, err)
+			assert.Equal(t, i, got.ID)
+		}
+		assert.Len(t, fileStore.Load(), 3)
+	})
+
+	t.Run("return empty games when no file", func(t *testing.T) {
+		fileStore := &record.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "games.json"),
+		}
+
+		assert.Equal(t, record.Games{}, fileStore.Load())
+	})
+}
+
+func TestIntegrationGamesFind(t *testing.T) {
+	games := record.Games{{ID: 1, Player: "first"}, {ID: 2, Player: "second"}}
+
+	t.Run("return the game with the ID", func(t *testing.T) {
+		got, err := games.Find(2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "second", got.Player)
+	})
+
+	t.Run("error when no game has the ID", func(t *testing.T) {
+		want := record.NewGameNotFoundError(3)
+		_, got := games.Find(3)
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestIntegrationGameVerify(t *testing.T) {
+	commitment, err := fairness.Commit("42")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		game record.Game
+		want bool
+	}{
+		{
+			name: "verify the commitment to the number",
+			game: record.Game{RandomNumber: 42, Commitment: &commitment},
+			want: true,
+		},
+		{
+			name: "reject a number changed after the commitment",
+			game: record.Game{RandomNumber: 43, Commitment: &commitment},
+			want: false,
+		},
+		{
+			name: "reject a game without a commitment",
+			game: record.Game{RandomNumber: 42},
+			want: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.game.Verify())
+		})
+	}
+}
+
+func TestIntegrationStepCommand(t *testing.T) {
+	t.Run("return the command playing the step", func(t *testing.T) {
+		testCases := []struct {
+			step record.Step
+			want engine.Command
+		}{
+			{
+				step: record.Step{Action: record.ActionGuess, Number: 42},
+				want: engine.Guess{Number: 42},
+			},
+			{
+				step: record.Step{Action: record.ActionHint},
+				want: engine.SpendHint{},
+			},
+			{
+				step: record.Step{Action: record.ActionGiveUp},
+				want: engine.GiveUp{},
+			},
+			{
+				step: record.Step{Action: record.ActionExpire},
+				want: engine.GuessTimeout{},
+			},
+			{
+				step: record.Step{Action: record.ActionTimeOut},
+				want: engine.GameTimeout{},
+			},
+		}
+
+		for _, tc := range testCases {
+			assert.Equal(t, tc.want, tc.step.Command())
+		}
+	})
+}
+
+type StubTimer struct {
+	calls int
+}
+
+func (s *StubTimer) Now() time.Time {
+	if s.calls == 0 {
+		s.calls++
+		return time.Date(2001, 1, 1, 1, 1, 0, 0, time.UTC)
+	}
+	return time.Date(2001, 1, 1, 1, 1, 10, 0, time.UTC)
+}
@@ -0,0 +1,64 @@
+// Package replay paces the steps of a recorded game when it is played back,
+// either at the recorded speed, accelerated, or one step per key press.
+package replay
+
+import (
+	"bufio"
+	"io"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/cli"
+)
+
+// Pacer waits before each step of a replay. Delay is the time the player
+// took between the previous step and this one. An error, such as io.EOF,
+// stops the replay.
+type Pacer interface {
+	Wait(delay time.Duration) error
+}
+
+// Clock waits the recorded delays divided by Speed, so that a speed of 1
+// replays in real time and a greater speed accelerates it. Sleep defaults to
+// time.Sleep.
+type Clock struct {
+	Speed float64
+	Sleep func(d time.Duration)
+}
+
+// Wait sleeps for the scaled delay.
+func (c *Clock) Wait(delay time.Duration) error {
+	speed := c.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	sleep := c.Sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	sleep(time.Duration(float64(delay) / speed))
+	return nil
+}
+
+// Stepper waits for a key press, read as a line from Source, before each
+// step, displaying the prompt to the writer first.
+type Stepper struct {
+	Source io.Reader
+	Writer io.Writer
+	Prompt string
+
+	reader *bufio.Reader
+}
+
+// Wait displays the prompt and reads a line, ignoring the delay. It returns
+// io.EOF once the source is closed.
+func (s *Stepper) Wait(time.Duration) error {
+	if s.reader == nil {
+		s.reader = bufio.NewReader(s.Source)
+	}
+
+	cli.Display(s.Writer, s.Prompt)
+	_, err := s.reader.ReadString('\n')
+	return err
+}
@@ -0,0 +1,52 @@
+package replay_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/replay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitClock(t *testing.T) {
+	t.Run("wait the delay divided by the speed", func(t *testing.T) {
+		testCases := []struct {
+			speed float64
+			want  time.Duration
+		}{
+			{speed: 1, want: 4 * time.Second},
+			{speed: 4, want: time.Second},
+			{speed: 0, want: 4 * time.Second},
+		}
+
+		for _, tc := range testCases {
+			var got time.Duration
+			clock := &replay.Clock{
+				Speed: tc.speed,
+				Sleep: func(d time.Duration) { got = d },
+			}
+
+			assert.NoError(t, clock.Wait(4*time.Second))
+			assert.Equal(t, tc.want, got)
+		}
+	})
+}
+
+func TestUnitStepper(t *testing.T) {
+	t.Run("wait for a line before each step", func(t *testing.T) {
+		writer := &bytes.Buffer{}
+		stepper := &replay.Stepper{
+			Source: strings.NewReader("\n\n"),
+			Writer: writer,
+			Prompt: "next",
+		}
+
+		assert.NoError(t, stepper.Wait(time.Second))
+		assert.NoError(t, stepper.Wait(time.Second))
+		assert.ErrorIs(t, stepper.Wait(time.Second), io.EOF)
+		assert.Equal(t, "nextnextnext", writer.String())
+	})
+}
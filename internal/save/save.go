@@ -0,0 +1,389 @@
+// Package save persists an in-progress round to a JSON file so that a player
+// who quits mid-game can resume it later. The secret number is sealed so it
+// can't be read from the file, and the whole record is signed so that edits
+// to any of its fields are detected.
+package save
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+)
+
+// NoSaveError represents an error occurring when resuming without any saved
+// game.
+type NoSaveError struct{}
+
+// Error returns a message indicating that there is no game to resume.
+func (e *NoSaveError) Error() string {
+	return "No saved game to resume."
+}
+
+// NewNoSaveError creates a new NoSaveError for testing.
+func NewNoSaveError() error {
+	return &NoSaveError{}
+}
+
+// SealError represents an error occurring when the sealed secret of a saved
+// game is malformed or was edited.
+type SealError struct{}
+
+// Error returns a message indicating that the saved game can't be trusted.
+func (e *SealError) Error() string {
+	return "Saved game is corrupted or was tampered with."
+}
+
+// NewSealError creates a new SealError for testing.
+func NewSealError() error {
+	return &SealError{}
+}
+
+// LiesError represents an error occurring when a lie was told past the
+// turns a saved game can hold.
+type LiesError struct {
+	Turn int
+}
+
+// LiesMessage is the message displayed when the lies of the round can't be
+// saved. It is public for testing purposes.
+const LiesMessage = "The lie of turn %d can't be saved, past the %d turns a saved game holds."
+
+// Error returns the error message for LiesError.
+func (e *LiesError) Error() string {
+	return fmt.Sprintf(LiesMessage, e.Turn, maxLieTurns)
+}
+
+// NewLiesError creates a new LiesError for testing.
+func NewLiesError(turn int) error {
+	return &LiesError{Turn: turn}
+}
+
+// sealKey keys the sealing of secrets and the signature of the records.
+// Being compiled into the game, it only guards against a casual look at the
+// file and edits by hand, not against anyone reading it from the binary.
+var sealKey = []byte("go-number-guessing-game/save")
+
+const (
+	nonceSize = 8
+	macSize   = 8
+	// maxLieTurns is the number of turns whose lies fit in the sealed mask.
+	maxLieTurns = 32
+)
+
+// Game is a suspended round as written to the save file. Min and Max are
+// only set for rounds played outside the default range, and MaxLies and Lies
+// for rounds allowing lies, whose positions are sealed like the number.
+// Variant is empty for the number variant, and Secrets holds the sealed
+// numbers of the multi variant. Seed draws the moves of the number in the
+// drift variant. Steps are the kinds of the attempts spent in order, the
+// guess steps guessing Guesses in turn. Commitment is the hash of the fairness commitment of the
+// round, and Nonce its sealed nonce, which would give the number away. MAC
+// signs every other field of the record.
+type Game struct {
+	Player      string        `json:"player"`
+	Level       string        `json:"level"`
+	MaxAttempts int           `json:"max_attempts"`
+	Min         int           `json:"min,omitempty"`
+	Max         int           `json:"max,omitempty"`
+	MaxLies     int           `json:"max_lies,omitempty"`
+	Variant     string        `json:"variant,omitempty"`
+	Secret      string        `json:"secret"`
+	Lies        string        `json:"lies,omitempty"`
+	Secrets     []string      `json:"secrets,omitempty"`
+	Seed        uint64        `json:"seed,omitempty"`
+	Commitment  string        `json:"commitment,omitempty"`
+	Nonce       string        `json:"nonce,omitempty"`
+	Steps       []string      `json:"steps"`
+	Guesses     []int         `json:"guesses"`
+	Elapsed     time.Duration `json:"elapsed"`
+	MAC         string        `json:"mac"`
+}
+
+// NewGame creates the saved game of a suspended round, sealing its number
+// and signing the record. It returns a LiesError when a lie was told past
+// the turns the record holds.
+func NewGame(resume engine.Resume) (Game, error) {
+	secret, err := seal(resume.RandomNumber)
+	if err != nil {
+		return Game{}, err
+	}
+
+	var lies string
+	if resume.MaxLies > 0 {
+		lieMask, err := liesMask(resume.Lies)
+		if err != nil {
+			return Game{}, err
+		}
+		if lies, err = seal(lieMask); err != nil {
+			return Game{}, err
+		}
+	}
+
+	var secrets []string
+	for _, number := range resume.Secrets {
+		sealed, err := seal(number)
+		if err != nil {
+			return Game{}, err
+		}
+		secrets = append(secrets, sealed)
+	}
+
+	var nonce string
+	if resume.Commitment.Hash != "" {
+		data, err := hex.DecodeString(resume.Commitment.Nonce)
+		if err != nil {
+			return Game{}, err
+		}
+		if nonce, err = sealBytes(data); err != nil {
+			return Game{}, err
+		}
+	}
+
+	saved := Game{
+		Player:      resume.Player,
+		Level:       resume.Level,
+		MaxAttempts: resume.MaxAttempts,
+		Min:         resume.Min,
+		Max:         resume.Max,
+		MaxLies:     resume.MaxLies,
+		Variant:     resume.Variant,
+		Secret:      secret,
+		Lies:        lies,
+		Secrets:     secrets,
+		Seed:        resume.Seed,
+		Commitment:  resume.Commitment.Hash,
+		Nonce:       nonce,
+		Steps:       resume.Steps,
+		Guesses:     resume.Guesses,
+		Elapsed:     resume.Elapsed,
+	}
+	if saved.MAC, err = saved.sign(); err != nil {
+		return Game{}, err
+	}
+
+	return saved, nil
+}
+
+// Resume returns the command resuming the saved round, unsealing its number,
+// lies, secrets and commitment nonce. It returns a SealError when any field
+// of the record was edited.
+func (g Game) Resume() (engine.Resume, error) {
+	mac, err := g.sign()
+	if err != nil || !hmac.Equal([]byte(mac), []byte(g.MAC)) {
+		return engine.Resume{}, NewSealError()
+	}
+
+	randomNumber, err := unseal(g.Secret)
+	if err != nil {
+		return engine.Resume{}, err
+	}
+
+	var lies []int
+	if g.MaxLies > 0 {
+		mask, err := unseal(g.Lies)
+		if err != nil {
+			return engine.Resume{}, err
+		}
+		lies = maskLies(mask)
+	}
+
+	var secrets []int
+	for _, sealed := range g.Secrets {
+		number, err := unseal(sealed)
+		if err != nil {
+			return engine.Resume{}, err
+		}
+		secrets = append(secrets, number)
+	}
+
+	var commitment fairness.Commitment
+	if g.Commitment != "" {
+		nonce, err := unsealBytes(g.Nonce, fairness.NonceSize)
+		if err != nil {
+			return engine.Resume{}, err
+		}
+		commitment = fairness.Commitment{
+			Hash:  g.Commitment,
+			Nonce: hex.EncodeToString(nonce),
+		}
+	}
+
+	return engine.Resume{
+		Player:       g.Player,
+		Level:        g.Level,
+		MaxAttempts:  g.MaxAttempts,
+		RandomNumber: randomNumber,
+		Min:          g.Min,
+		Max:          g.Max,
+		MaxLies:      g.MaxLies,
+		Variant:      g.Variant,
+		Secrets:      secrets,
+		Seed:         g.Seed,
+		Commitment:   commitment,
+		Steps:        g.Steps,
+		Guesses:      g.Guesses,
+		Lies:         lies,
+		Elapsed:      g.Elapsed,
+	}, nil
+}
+
+// Store defines methods for saving, loading and deleting the suspended game,
+// facilitating testing.
+type Store interface {
+	Save(game Game) error
+	Load() (Game, error)
+	Delete() error
+}
+
+// FileStore manages the file path of the saved game, which must be a JSON
+// file.
+type FileStore struct {
+	FilePath string
+}
+
+// Save writes the game, replacing any previously saved one.
+func (s *FileStore) Save(game Game) error {
+	byt, err := json.Marshal(game)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.FilePath, byt, 0o600)
+}
+
+// Load reads the saved game. It returns a NoSaveError when no game was saved.
+func (s *FileStore) Load() (Game, error) {
+	byt, err := os.ReadFile(s.FilePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Game{}, NewNoSaveError()
+	}
+	if err != nil {
+		return Game{}, err
+	}
+
+	var game Game
+	if err = json.Unmarshal(byt, &game); err != nil {
+		return Game{}, NewSealError()
+	}
+
+	return game, nil
+}
+
+// Delete removes the saved game so that it is resumed only once.
+func (s *FileStore) Delete() error {
+	err := os.Remove(s.FilePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func seal(number int) (string, error) {
+	data := make([]byte, 4)
+	binary.BigEndian.PutUint32(data, uint32(number))
+	return sealBytes(data)
+}
+
+func unseal(secret string) (int, error) {
+	data, err := unsealBytes(secret, 4)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint32(data)), nil
+}
+
+// sealBytes seals data of up to 32 bytes, the size of the keystream.
+func sealBytes(data []byte) (string, error) {
+	size := nonceSize + len(data)
+	sealed := make([]byte, size, size+macSize)
+	if _, err := rand.Read(sealed[:nonceSize]); err != nil {
+		return "", err
+	}
+
+	copy(sealed[nonceSize:], data)
+	mask(sealed[:nonceSize], sealed[nonceSize:])
+	sealed = append(sealed, sum(sealed)...)
+
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// unsealBytes unseals the data of the size sealed by sealBytes.
+func unsealBytes(secret string, size int) ([]byte, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(secret)
+	if err != nil || len(sealed) != nonceSize+size+macSize {
+		return nil, NewSealError()
+	}
+
+	body, mac := sealed[:nonceSize+size], sealed[nonceSize+size:]
+	if !hmac.Equal(mac, sum(body)) {
+		return nil, NewSealError()
+	}
+
+	mask(body[:nonceSize], body[nonceSize:])
+	return body[nonceSize:], nil
+}
+
+// sign returns the signature of the serialized record without its MAC.
+func (g Game) sign() (string, error) {
+	g.MAC = ""
+	byt, err := json.Marshal(g)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, sealKey)
+	mac.Write(byt)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// liesMask packs the positions of the lies, from 1, into the bits of a
+// number to seal it. It returns a LiesError for a position past
+// maxLieTurns.
+func liesMask(lies []int) (int, error) {
+	var mask uint32
+	for _, position := range lies {
+		if position < 1 || position > maxLieTurns {
+			return 0, NewLiesError(position)
+		}
+		mask |= 1 << (position - 1)
+	}
+	return int(mask), nil
+}
+
+// maskLies unpacks the positions of the lies from the bits of the number.
+func maskLies(mask int) []int {
+	var lies []int
+	for position := 1; position <= maxLieTurns; position++ {
+		if mask&(1<<(position-1)) != 0 {
+			lies = append(lies, position)
+		}
+	}
+	return lies
+}
+
+// mask XORs the number bytes with a keystream derived from the nonce, which
+// both seals and unseals them.
+func mask(nonce, number []byte) {
+	stream := sha256.Sum256(append(append([]byte{}, sealKey...), nonce...))
+	for i := range number {
+		number[i] ^= stream[i]
+	}
+}
+
+func sum(body []byte) []byte {
+	mac := hmac.New(sha256.New, sealKey)
+	mac.Write(body)
+	return mac.Sum(nil)[:macSize]
+}
@@ -0,0 +1,279 @@
+package save_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/save"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationGame(t *testing.T) {
+	resume := engine.Resume{
+		Player:       "test",
+		Level:        "Medium",
+		MaxAttempts:  5,
+		RandomNumber: 42,
+		Steps: []string{
+			engine.StepGuess, engine.StepHint, engine.StepExpire, engine.StepGuess,
+		},
+		Guesses: []int{50, 25},
+		Elapsed: 30 * time.Second,
+	}
+
+	t.Run("round trip a suspended round", func(t *testing.T) {
+		game, err := save.NewGame(resume)
+		assert.NoError(t, err)
+
+		got, err := game.Resume()
+
+		assert.NoError(t, err)
+		assert.Equal(t, resume, got)
+	})
+
+	t.Run("seal the number", func(t *testing.T) {
+		first, err := save.NewGame(resume)
+		assert.NoError(t, err)
+		second, err := save.NewGame(resume)
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, "42", first.Secret)
+		assert.NotEqual(t, first.Secret, second.Secret)
+	})
+
+	t.Run("round trip and seal the lies of the round", func(t *testing.T) {
+		lying := resume
+		lying.MaxLies = 2
+		lying.Lies = []int{1, 2}
+
+		game, err := save.NewGame(lying)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, game.Lies)
+
+		got, err := game.Resume()
+
+		assert.NoError(t, err)
+		assert.Equal(t, lying, got)
+
+		game.Lies = flip(game.Lies)
+		want := save.NewSealError()
+		_, err = game.Resume()
+		assert.ErrorAs(t, err, &want)
+	})
+
+	t.Run("round trip and seal the secrets of the multi variant", func(t *testing.T) {
+		multi := resume
+		multi.Variant = game.MultiVariant
+		multi.Secrets = []int{42, 7, 91}
+
+		saved, err := save.NewGame(multi)
+		assert.NoError(t, err)
+		assert.Len(t, saved.Secrets, 3)
+		assert.NotContains(t, saved.Secrets, "7")
+
+		got, err := saved.Resume()
+
+		assert.NoError(t, err)
+		assert.Equal(t, multi, got)
+
+		saved.Secrets[1] = flip(saved.Secrets[1])
+		want := save.NewSealError()
+		_, err = saved.Resume()
+		assert.ErrorAs(t, err, &want)
+	})
+
+	t.Run("round trip the largest code of the code variant", func(t *testing.T) {
+		coded := resume
+		coded.Variant = game.CodeVariant
+		coded.Min, coded.Max = game.CodeBounds(game.MaxCodeDigits)
+		coded.RandomNumber = coded.Max
+		coded.Guesses = []int{coded.Min}
+
+		saved, err := save.NewGame(coded)
+		assert.NoError(t, err)
+
+		got, err := saved.Resume()
+
+		assert.NoError(t, err)
+		assert.Equal(t, coded, got)
+	})
+
+	t.Run("round trip the commitment and seal its nonce", func(t *testing.T) {
+		committed := resume
+		commitment, err := fairness.Commit("42")
+		assert.NoError(t, err)
+		committed.Commitment = commitment
+
+		saved, err := save.NewGame(committed)
+		assert.NoError(t, err)
+		assert.Equal(t, commitment.Hash, saved.Commitment)
+		assert.NotEqual(t, commitment.Nonce, saved.Nonce)
+
+		got, err := saved.Resume()
+
+		assert.NoError(t, err)
+		assert.Equal(t, committed, got)
+
+		saved.Nonce = flip(saved.Nonce)
+		want := save.NewSealError()
+		_, err = saved.Resume()
+		assert.ErrorAs(t, err, &want)
+	})
+
+	t.Run("error when the secret was edited", func(t *testing.T) {
+		game, err := save.NewGame(resume)
+		assert.NoError(t, err)
+
+		testCases := []string{
+			"",
+			"not-base64!",
+			flip(game.Secret),
+		}
+
+		for _, secret := range testCases {
+			game.Secret = secret
+
+			want := save.NewSealError()
+			_, got := game.Resume()
+
+			assert.NotNil(t, got)
+			assert.ErrorAs(t, got, &want)
+		}
+	})
+
+	t.Run("error when any field of the record was edited", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			edit        func(game *save.Game)
+		}{
+			{
+				description: "guesses",
+				edit:        func(game *save.Game) { game.Guesses = []int{42} },
+			},
+			{
+				description: "steps",
+				edit:        func(game *save.Game) { game.Steps = game.Steps[1:] },
+			},
+			{
+				description: "max attempts",
+				edit:        func(game *save.Game) { game.MaxAttempts = 10 },
+			},
+			{
+				description: "elapsed time",
+				edit:        func(game *save.Game) { game.Elapsed = 0 },
+			},
+			{
+				description: "allowed lies",
+				edit:        func(game *save.Game) { game.MaxLies = 5 },
+			},
+			{
+				description: "signature",
+				edit:        func(game *save.Game) { game.MAC = flip(game.MAC) },
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				game, err := save.NewGame(resume)
+				assert.NoError(t, err)
+
+				tc.edit(&game)
+
+				want := save.NewSealError()
+				_, got := game.Resume()
+				assert.ErrorAs(t, got, &want)
+			})
+		}
+	})
+
+	t.Run("error when a lie is past the turns of the record", func(t *testing.T) {
+		lying := resume
+		lying.MaxLies = 2
+		lying.Lies = []int{3, 33}
+
+		want := save.NewLiesError(33)
+		_, got := save.NewGame(lying)
+
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestIntegrationFileStore(t *testing.T) {
+	t.Run("save, load and delete the game", func(t *testing.T) {
+		fileStore := &save.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "save.json"),
+		}
+		game := save.Game{
+			Player:      "test",
+			Level:       "Easy",
+			MaxAttempts: 10,
+			Secret:      "secret",
+			Guesses:     []int{10},
+		}
+
+		assert.NoError(t, fileStore.Save(game))
+
+		got, err := fileStore.Load()
+		assert.NoError(t, err)
+		assert.Equal(t, game, got)
+
+		assert.NoError(t, fileStore.Delete())
+		_, err = os.Stat(fileStore.FilePath)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("resume the saved game once loaded", func(t *testing.T) {
+		fileStore := &save.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "save.json"),
+		}
+		resume := engine.Resume{
+			Player:       "test",
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 42,
+			MaxLies:      1,
+			Lies:         []int{1},
+			Steps:        []string{engine.StepGuess},
+			Guesses:      []int{50},
+			Elapsed:      time.Second,
+		}
+		saved, err := save.NewGame(resume)
+		assert.NoError(t, err)
+		assert.NoError(t, fileStore.Save(saved))
+
+		loaded, err := fileStore.Load()
+		assert.NoError(t, err)
+		got, err := loaded.Resume()
+
+		assert.NoError(t, err)
+		assert.Equal(t, resume, got)
+	})
+
+	t.Run("error when no game was saved", func(t *testing.T) {
+		fileStore := &save.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "save.json"),
+		}
+
+		want := save.NewNoSaveError()
+		_, got := fileStore.Load()
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.NoError(t, fileStore.Delete())
+	})
+}
+
+func flip(secret string) string {
+	replacement := "A"
+	if strings.HasPrefix(secret, "A") {
+		replacement = "B"
+	}
+	return replacement + secret[1:]
+}
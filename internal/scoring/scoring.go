@@ -0,0 +1,84 @@
+// Package scoring computes the points of a won round from a configurable
+// formula, so that the leaderboard can rank rounds across levels rather than
+// by level first.
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-number-guessing-game/internal/engine"
+)
+
+// FormulaError represents an error occurring when a setting of the scoring
+// config isn't a number.
+type FormulaError struct {
+	Key   string
+	Value string
+}
+
+// FormulaMessage is the message displayed when a scoring setting is invalid.
+// It is public for testing purposes.
+const FormulaMessage = "Scoring setting %q must be a number, got %q."
+
+// Error returns the error message for FormulaError.
+func (e *FormulaError) Error() string {
+	return fmt.Sprintf(FormulaMessage, e.Key, e.Value)
+}
+
+// NewFormulaError creates a new FormulaError for testing.
+func NewFormulaError(key, value string) error {
+	return &FormulaError{Key: key, Value: value}
+}
+
+// Formula scores a won round: Base points by lower-cased level, plus
+// UnusedAttempt per attempt left, minus TimeDecay per second played and the
+// penalties per hint and per redundant guess. The zero value scores nothing.
+type Formula struct {
+	Base             map[string]int
+	UnusedAttempt    int
+	TimeDecay        float64
+	HintPenalty      int
+	RedundantPenalty int
+}
+
+// NewFormula reads the formula from the flattened scoring config, with the
+// bases under "base.<level>". Missing settings count as zero.
+func NewFormula(config map[string]string) (Formula, error) {
+	formula := Formula{Base: map[string]int{}}
+
+	for key, value := range config {
+		var err error
+		switch {
+		case strings.HasPrefix(key, "base."):
+			formula.Base[strings.TrimPrefix(key, "base.")], err = strconv.Atoi(value)
+		case key == "unused_attempt":
+			formula.UnusedAttempt, err = strconv.Atoi(value)
+		case key == "time_decay":
+			formula.TimeDecay, err = strconv.ParseFloat(value, 64)
+		case key == "hint_penalty":
+			formula.HintPenalty, err = strconv.Atoi(value)
+		case key == "redundant_penalty":
+			formula.RedundantPenalty, err = strconv.Atoi(value)
+		}
+
+		if err != nil {
+			return Formula{}, NewFormulaError(key, value)
+		}
+	}
+
+	return formula, nil
+}
+
+// Points returns the points of the won round, never below zero.
+func (f Formula) Points(won engine.GameWon) int {
+	points := float64(f.Base[strings.ToLower(won.Level)])
+	points += float64(f.UnusedAttempt * (won.MaxAttempts - won.Attempts))
+	points -= f.TimeDecay * won.Time.Seconds()
+	points -= float64(f.HintPenalty * won.HintsUsed)
+	points -= float64(f.RedundantPenalty * won.Redundant)
+
+	return max(int(math.Round(points)), 0)
+}
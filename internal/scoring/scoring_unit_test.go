@@ -0,0 +1,110 @@
+package scoring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/scoring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNewFormula(t *testing.T) {
+	t.Run("read the formula from the config", func(t *testing.T) {
+		got, err := scoring.NewFormula(map[string]string{
+			"base.easy":         "100",
+			"base.hard":         "500",
+			"unused_attempt":    "50",
+			"time_decay":        "0.5",
+			"hint_penalty":      "40",
+			"redundant_penalty": "60",
+		})
+
+		want := scoring.Formula{
+			Base:             map[string]int{"easy": 100, "hard": 500},
+			UnusedAttempt:    50,
+			TimeDecay:        0.5,
+			HintPenalty:      40,
+			RedundantPenalty: 60,
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("error when a setting isn't a number", func(t *testing.T) {
+		want := scoring.NewFormulaError("hint_penalty", "many")
+		_, got := scoring.NewFormula(map[string]string{"hint_penalty": "many"})
+
+		assert.NotNil(t, got)
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestUnitFormulaPoints(t *testing.T) {
+	formula := scoring.Formula{
+		Base:             map[string]int{"easy": 100, "hard": 500},
+		UnusedAttempt:    50,
+		TimeDecay:        1,
+		HintPenalty:      40,
+		RedundantPenalty: 60,
+	}
+
+	t.Run("score the won round", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			won         engine.GameWon
+			want        int
+		}{
+			{
+				description: "bonus per unused attempt",
+				won:         engine.GameWon{Level: "Hard", MaxAttempts: 3, Attempts: 1},
+				want:        600,
+			},
+			{
+				description: "time decay",
+				won: engine.GameWon{
+					Level:       "Easy",
+					MaxAttempts: 10,
+					Attempts:    10,
+					Time:        30 * time.Second,
+				},
+				want: 70,
+			},
+			{
+				description: "penalties for hints and redundant guesses",
+				won: engine.GameWon{
+					Level:       "Hard",
+					MaxAttempts: 3,
+					Attempts:    3,
+					HintsUsed:   1,
+					Redundant:   1,
+				},
+				want: 400,
+			},
+			{
+				description: "never below zero",
+				won: engine.GameWon{
+					Level:       "Easy",
+					MaxAttempts: 10,
+					Attempts:    10,
+					Time:        time.Hour,
+				},
+				want: 0,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				assert.Equal(t, tc.want, formula.Points(tc.won))
+			})
+		}
+	})
+
+	t.Run("score nothing with the zero formula", func(t *testing.T) {
+		won := engine.GameWon{Level: "Hard", MaxAttempts: 3, Attempts: 1}
+
+		assert.Equal(t, 0, scoring.Formula{}.Points(won))
+	})
+}
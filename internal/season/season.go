@@ -0,0 +1,229 @@
+// Package season splits the leaderboard into seasons. A season lasts the
+// length set in the config, or until a new one is started by hand, and its
+// champion, the best ranked player of its leaderboard, is kept once it is
+// over.
+package season
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/olekukonko/tablewriter"
+)
+
+// NoChampions is the message displayed when no season has a champion yet.
+// It is public for testing purposes.
+const NoChampions = "No season champions yet."
+
+// LengthError represents an error occurring when the season length of the
+// config isn't a number of days.
+type LengthError struct {
+	Value string
+}
+
+// LengthMessage is the message displayed when the season length is invalid.
+// It is public for testing purposes.
+const LengthMessage = "Season length must be a number of days, got %q."
+
+// Error returns the error message for LengthError.
+func (e *LengthError) Error() string {
+	return fmt.Sprintf(LengthMessage, e.Value)
+}
+
+// NewLengthError creates a new LengthError for testing.
+func NewLengthError(value string) error {
+	return &LengthError{Value: value}
+}
+
+// NoSeasonError represents an error occurring when the season asked for
+// hasn't been played.
+type NoSeasonError struct {
+	Number int
+}
+
+// NoSeasonMessage is the message displayed when the season doesn't exist.
+// It is public for testing purposes.
+const NoSeasonMessage = "Season %d hasn't been played."
+
+// Error returns the error message for NoSeasonError.
+func (e *NoSeasonError) Error() string {
+	return fmt.Sprintf(NoSeasonMessage, e.Number)
+}
+
+// NewNoSeasonError creates a new NoSeasonError for testing.
+func NewNoSeasonError(number int) error {
+	return &NoSeasonError{Number: number}
+}
+
+// NewLength reads the season length from the flattened seasons config,
+// under "length_days". A missing or zero length lasts until a new season is
+// started by hand.
+func NewLength(config map[string]string) (time.Duration, error) {
+	value, ok := config["length_days"]
+	if !ok {
+		return 0, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return 0, NewLengthError(value)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// Season is a numbered season, from 1. Ended, Champion and ChampionID are
+// set once it is over, the champion being empty for a season without scores
+// and its ID zero when the champion has no profile.
+type Season struct {
+	Number     int       `json:"number"`
+	Started    time.Time `json:"started"`
+	Ended      time.Time `json:"ended,omitempty"`
+	Champion   string    `json:"champion,omitempty"`
+	ChampionID int       `json:"champion_id,omitempty"`
+}
+
+// Seasons is the current season with the archive of the past ones, oldest
+// first, and the numbers of the seasons each champion won, by the profile
+// key of the champion.
+type Seasons struct {
+	Current   Season           `json:"current"`
+	Archive   []Season         `json:"archive"`
+	Champions map[string][]int `json:"champions"`
+}
+
+// New returns the first season, started at now.
+func New(now time.Time) Seasons {
+	return Seasons{
+		Current:   Season{Number: 1, Started: now},
+		Archive:   []Season{},
+		Champions: map[string][]int{},
+	}
+}
+
+// Due reports whether the current season lasted the length at now. Seasons
+// without a length are never due.
+func (s Seasons) Due(now time.Time, length time.Duration) bool {
+	return length > 0 && !now.Before(s.Current.Started.Add(length))
+}
+
+// Close archives the current season at now with its champion and the
+// profile ID of the champion, and starts the next one.
+func (s *Seasons) Close(now time.Time, champion string, championID int) {
+	s.Current.Ended = now
+	s.Current.Champion = champion
+	s.Current.ChampionID = championID
+	s.Archive = append(s.Archive, s.Current)
+	if champion != "" {
+		if s.Champions == nil {
+			s.Champions = map[string][]int{}
+		}
+		key := profile.Key(champion, championID)
+		s.Champions[key] = append(s.Champions[key], s.Current.Number)
+	}
+
+	s.Current = Season{Number: s.Current.Number + 1, Started: now}
+}
+
+// Find returns the season with the number, current or archived, or a
+// NoSeasonError when it hasn't been played.
+func (s Seasons) Find(number int) (Season, error) {
+	if number == s.Current.Number {
+		return s.Current, nil
+	}
+	for _, season := range s.Archive {
+		if season.Number == number {
+			return season, nil
+		}
+	}
+	return Season{}, NewNoSeasonError(number)
+}
+
+// ChampionsString formats the champions as an ASCII table, most titles
+// first, then by name, each champion shown by the name of the last title.
+func (s Seasons) ChampionsString() string {
+	if len(s.Champions) == 0 {
+		return NoChampions
+	}
+
+	players := make([]string, 0, len(s.Champions))
+	names := map[string]string{}
+	for player, numbers := range s.Champions {
+		players = append(players, player)
+		names[player] = player
+		if last, err := s.Find(numbers[len(numbers)-1]); err == nil && last.Champion != "" {
+			names[player] = last.Champion
+		}
+	}
+	sort.Slice(players, func(i, j int) bool {
+		a, b := s.Champions[players[i]], s.Champions[players[j]]
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return names[players[i]] < names[players[j]]
+	})
+
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Player", "Titles", "Seasons"})
+
+	for _, player := range players {
+		numbers := make([]string, len(s.Champions[player]))
+		for i, number := range s.Champions[player] {
+			numbers[i] = strconv.Itoa(number)
+		}
+		table.Append([]string{
+			names[player],
+			strconv.Itoa(len(numbers)),
+			strings.Join(numbers, ", "),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Store defines methods for loading and saving the seasons, facilitating
+// testing.
+type Store interface {
+	Load(now time.Time) Seasons
+	Save(seasons Seasons) error
+}
+
+// FileStore manages the file path of the seasons, which must be a JSON file.
+type FileStore struct {
+	FilePath string
+}
+
+// Load retrieves the seasons. If none were saved, it returns the first
+// season, started at now.
+func (s *FileStore) Load(now time.Time) Seasons {
+	byt, err := os.ReadFile(s.FilePath)
+	if err != nil {
+		return New(now)
+	}
+
+	var seasons Seasons
+	if err = json.Unmarshal(byt, &seasons); err != nil ||
+		seasons.Current.Number == 0 {
+		return New(now)
+	}
+
+	return seasons
+}
+
+// Save writes the seasons, replacing the previous ones.
+func (s *FileStore) Save(seasons Seasons) error {
+	byt, err := json.Marshal(seasons)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.FilePath, byt, 0o644)
+}
@@ -0,0 +1,122 @@
+package season_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/season"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationNewLength(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			config      map[string]string
+			want        time.Duration
+		}{
+			{
+				description: "length in days",
+				config:      map[string]string{"length_days": "30"},
+				want:        30 * 24 * time.Hour,
+			},
+			{
+				description: "no length without config",
+				config:      map[string]string{},
+				want:        0,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got, err := season.NewLength(tc.config)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("error when the length isn't a number of days", func(t *testing.T) {
+		_, got := season.NewLength(map[string]string{"length_days": "-1"})
+
+		want := season.NewLengthError("-1")
+		assert.ErrorAs(t, got, &want)
+		assert.Equal(t, want.Error(), got.Error())
+	})
+}
+
+func TestIntegrationSeasonsClose(t *testing.T) {
+	t.Run("archive the seasons and record their champions", func(t *testing.T) {
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		got := season.New(start)
+		assert.False(t, got.Due(start.Add(time.Hour), 0))
+		assert.False(t, got.Due(start.Add(time.Hour), 24*time.Hour))
+		assert.True(t, got.Due(start.Add(24*time.Hour), 24*time.Hour))
+
+		got.Close(start.Add(24*time.Hour), "ann", 0)
+		got.Close(start.Add(48*time.Hour), "", 0)
+		got.Close(start.Add(72*time.Hour), "ann", 0)
+
+		assert.Equal(t, 4, got.Current.Number)
+		assert.Equal(t, start.Add(72*time.Hour), got.Current.Started)
+		assert.Len(t, got.Archive, 3)
+		assert.Equal(t, map[string][]int{"ann": {1, 3}}, got.Champions)
+		assert.Contains(t, got.ChampionsString(), "| ann    |      2 | 1, 3    |")
+
+		second, err := got.Find(2)
+		assert.NoError(t, err)
+		assert.Equal(t, season.Season{
+			Number:  2,
+			Started: start.Add(24 * time.Hour),
+			Ended:   start.Add(48 * time.Hour),
+		}, second)
+
+		_, err = got.Find(5)
+		want := season.NewNoSeasonError(5)
+		assert.ErrorAs(t, err, &want)
+	})
+
+	t.Run("record the champions by profile under their last name", func(t *testing.T) {
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		got := season.New(start)
+
+		got.Close(start.Add(24*time.Hour), "Bob", 2)
+		got.Close(start.Add(48*time.Hour), "Robert", 2)
+		got.Close(start.Add(72*time.Hour), "Bob", 0)
+
+		assert.Equal(t, 2, got.Archive[1].ChampionID)
+		assert.Equal(t, map[string][]int{
+			"profile:2": {1, 2},
+			"Bob":       {3},
+		}, got.Champions)
+		assert.Contains(t, got.ChampionsString(), "| Robert |      2 | 1, 2    |")
+		assert.Contains(t, got.ChampionsString(), "| Bob    |      1 |       3 |")
+	})
+
+	t.Run("return message to user when no champions", func(t *testing.T) {
+		got := season.New(time.Now())
+
+		assert.Equal(t, season.NoChampions, got.ChampionsString())
+	})
+}
+
+func TestIntegrationFileStore(t *testing.T) {
+	t.Run("save and load the seasons", func(t *testing.T) {
+		fileStore := &season.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "seasons.json"),
+		}
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, season.New(start), fileStore.Load(start))
+
+		saved := season.New(start)
+		saved.Close(start.Add(time.Hour), "bob", 2)
+		assert.NoError(t, fileStore.Save(saved))
+
+		got := fileStore.Load(time.Now())
+
+		assert.Equal(t, saved, got)
+	})
+}
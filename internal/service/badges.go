@@ -0,0 +1,34 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/go-number-guessing-game/internal/achievement"
+	"github.com/go-number-guessing-game/internal/cli"
+)
+
+// ShowBadges displays the badges earned by every player and, when a player
+// is given, every badge with the date the player earned it.
+func (g *Game) ShowBadges(player string) {
+	players := achievement.Players{}
+	if g.AchievementStore != nil {
+		players = g.AchievementStore.Load()
+	}
+
+	messages := []string{
+		g.GameConfig["badges_players"],
+		g.GameConfig["newline"],
+		players.String(),
+	}
+
+	if player != "" {
+		messages = append(messages,
+			g.GameConfig["newline"],
+			fmt.Sprintf(g.GameConfig["badges_player"], player),
+			g.GameConfig["newline"],
+			players[player].String(),
+		)
+	}
+
+	cli.Display(g.Writer, append(messages, g.GameConfig["newline"]))
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/config"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/parser"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/timer"
+)
+
+// PickProfile lists the profiles and asks the player to pick one by its ID
+// or name, however the name is cased or spaced, creating the profile of a
+// new name. The profile picked plays every round of the session, with its
+// preferred locale and theme when Locales and Themes are set.
+func (g *Game) PickProfile() {
+	profiles := g.ProfileStore.Load()
+	if len(profiles) > 0 {
+		cli.Display(g.Writer, []string{
+			g.GameConfig["profiles"],
+			g.GameConfig["newline"],
+			profiles.String(),
+		})
+	}
+	cli.Display(g.Writer, g.GameConfig["profile_pick"])
+
+	var picked profile.Profile
+pickLoop:
+	for {
+		g.reporter().Prompt(cli.InputPlayer)
+		input, err := g.nextInput(
+			cli.InputPlayer,
+			g.InputSource.NextPlayerInput,
+			timer.Deadline{},
+		)
+		if errors.Is(err, io.EOF) {
+			g.closed = true
+			return
+		}
+
+		if id, atoiErr := strconv.Atoi(input); err == nil && atoiErr == nil {
+			if p, ok := profiles.Get(id); ok {
+				picked = p
+				break pickLoop
+			}
+		}
+
+		var name string
+		if err == nil {
+			name, err = g.NameLimits.Parse(input)
+		}
+		if err != nil {
+			g.displayError(err, []string{
+				g.GameConfig["spacer"],
+				g.GameConfig["profile_pick"],
+			})
+			continue pickLoop
+		}
+
+		if p, ok := profiles.Find(name); ok {
+			picked = p
+			break pickLoop
+		}
+
+		picked = profiles.Create(name, time.Now())
+		if err := g.ProfileStore.Save(profiles); err != nil {
+			g.displayError(err, []string{g.GameConfig["newline"]})
+		}
+		cli.Display(g.Writer, []string{
+			fmt.Sprintf(g.GameConfig["profile_created"], picked.DisplayName),
+			g.GameConfig["spacer"],
+		})
+		g.usePreferences(picked)
+		return
+	}
+
+	cli.Display(g.Writer, []string{
+		fmt.Sprintf(g.GameConfig["profile_welcome"], picked.DisplayName),
+		g.GameConfig["spacer"],
+	})
+	g.usePreferences(picked)
+}
+
+// usePreferences plays the next rounds as the profile, with the messages
+// of its preferred locale and its preferred theme.
+func (g *Game) usePreferences(p profile.Profile) {
+	g.Profile = &p
+	if messages, ok := g.Locales[p.Locale]; ok {
+		g.GameConfig = config.MergeConfig(g.GameConfig, messages)
+		if g.Screen != nil {
+			g.Screen.GameConfig = g.GameConfig
+		}
+	}
+	if g.Themes != nil && p.Theme != "" {
+		g.Theme = cli.NewTheme(g.Themes, p.Theme)
+	}
+}
+
+// preferredLevel announces and returns the preferred level of the profile,
+// played without asking for the difficulty.
+func (g *Game) preferredLevel() (string, int) {
+	level, maxAttempts, err := parser.ParseDifficultyInput(g.Profile.Level)
+	if err != nil {
+		cli.Display(g.Writer, g.GameConfig["difficulty"])
+		return g.getUserDifficultyInput()
+	}
+
+	cli.Display(g.Writer, []string{
+		fmt.Sprintf(g.GameConfig["profile_level"], level),
+		g.GameConfig["spacer"],
+	})
+	return level, maxAttempts
+}
+
+// profileID returns the ID of the profile of the player of the round, or
+// zero when the player has none.
+func (g *Game) profileID(start engine.Command) int {
+	if g.ProfileStore == nil {
+		return 0
+	}
+
+	var player string
+	switch c := start.(type) {
+	case engine.Start:
+		player = c.Player
+	case engine.Resume:
+		player = c.Player
+	}
+
+	p, _ := g.ProfileStore.Load().Find(player)
+	return p.ID
+}
+
+// ShowProfiles displays the profiles of the players.
+func (g *Game) ShowProfiles() {
+	var profiles profile.Profiles
+	if g.ProfileStore != nil {
+		profiles = g.ProfileStore.Load()
+	}
+
+	cli.Display(g.Writer, []string{
+		g.GameConfig["profiles"],
+		g.GameConfig["newline"],
+		profiles.String(),
+		g.GameConfig["newline"],
+	})
+}
+
+// SetProfile sets the preferred difficulty choice, locale and theme of the
+// profile with the name, leaving the empty ones unchanged. The locale must
+// be one of Locales, and the theme one of Themes.
+func (g *Game) SetProfile(name, level, locale, theme string) {
+	err := g.setProfile(name, level, locale, theme)
+	if err != nil {
+		g.displayError(err, []string{g.GameConfig["newline"]})
+		return
+	}
+
+	cli.Display(g.Writer, []string{
+		fmt.Sprintf(g.GameConfig["profile_updated"], profile.DisplayName(name)),
+		g.GameConfig["newline"],
+	})
+}
+
+func (g *Game) setProfile(name, level, locale, theme string) error {
+	if g.ProfileStore == nil {
+		return profile.NewNoProfileError(name)
+	}
+
+	profiles := g.ProfileStore.Load()
+	p, ok := profiles.Find(name)
+	if !ok {
+		return profile.NewNoProfileError(name)
+	}
+
+	if level != "" {
+		if _, _, err := parser.ParseDifficultyInput(level); err != nil {
+			return err
+		}
+		p.Level = level
+	}
+	if locale != "" {
+		if _, ok := g.Locales[locale]; !ok {
+			return profile.NewLocaleError(locale)
+		}
+		p.Locale = locale
+	}
+	if theme != "" {
+		if !cli.HasTheme(g.Themes, theme) {
+			return profile.NewThemeError(theme)
+		}
+		p.Theme = theme
+	}
+
+	profiles.Update(p)
+	return g.ProfileStore.Save(profiles)
+}
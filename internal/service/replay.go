@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/go-number-guessing-game/internal/replay"
+)
+
+// ReplayGame plays back the recorded game with the ID step by step, rendered
+// like a live round along with the narrowing range, waiting on the pacer
+// before each step.
+func (g *Game) ReplayGame(id int, pacer replay.Pacer) {
+	var games record.Games
+	if g.RecordStore != nil {
+		games = g.RecordStore.Load()
+	}
+
+	recorded, err := games.Find(id)
+	if err != nil {
+		g.displayError(err, []string{
+			g.GameConfig["newline"],
+		})
+		return
+	}
+
+	cli.Display(g.Writer, []string{
+		fmt.Sprintf(g.GameConfig["replay_intro"],
+			recorded.ID,
+			recorded.Player,
+			recorded.Level,
+		),
+		g.GameConfig["spacer"],
+	})
+
+	clock := &replayTimer{}
+	round := &engine.Engine{Timer: clock, Hints: g.Rounds.Hints}
+	round.Subscribe(&view{game: g, engine: round, announcing: true})
+	_ = round.Handle(recorded.Start())
+
+	var elapsed time.Duration
+	for _, step := range recorded.Steps {
+		if err := pacer.Wait(step.Elapsed - elapsed); err != nil {
+			break
+		}
+		elapsed = step.Elapsed
+		clock.elapsed = step.Elapsed
+
+		if err := round.Handle(step.Command()); err != nil {
+			g.displayError(err, []string{
+				g.GameConfig["newline"],
+			})
+			break
+		}
+	}
+
+	cli.Display(g.Writer, []string{
+		g.GameConfig["replay_end"],
+		g.GameConfig["newline"],
+	})
+}
+
+// replayTimer tells the engine the time of the step being replayed, so that
+// the round ends with the recorded time.
+type replayTimer struct {
+	elapsed time.Duration
+}
+
+// Now returns the time of the step, counted from the zero time.
+func (t *replayTimer) Now() time.Time {
+	return time.Time{}.Add(t.elapsed)
+}
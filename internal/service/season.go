@@ -0,0 +1,145 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/season"
+	"github.com/go-number-guessing-game/internal/store"
+)
+
+// dateLayout formats the dates of the seasons.
+const dateLayout = "2006-01-02"
+
+// RollSeason scopes the scores store to the current season. When force is
+// set, or the current season lasted SeasonLength, the season is closed
+// first: its best ranked player is crowned champion and the next season
+// starts with an empty leaderboard.
+func (g *Game) RollSeason(scoresStore *store.ScoresStore, force bool) {
+	if g.SeasonStore == nil {
+		return
+	}
+
+	// The first season is saved on load, so that its length runs from the
+	// first game.
+	now := time.Now()
+	seasons := g.SeasonStore.Load(now)
+	closing := seasons.Current.Number
+	scoresStore.Season = closing
+	due := force || seasons.Due(now, g.SeasonLength)
+
+	var champion string
+	if due {
+		var championID int
+		if top := scoresStore.Load().Rank(g.Ranking, 1); len(top) > 0 {
+			champion, championID = top[0].Player, top[0].ProfileID
+		}
+		seasons.Close(now, champion, championID)
+	}
+
+	if err := g.SeasonStore.Save(seasons); err != nil {
+		g.displayError(err, []string{g.GameConfig["newline"]})
+		return
+	}
+	scoresStore.Season = seasons.Current.Number
+	if !due {
+		return
+	}
+
+	message := fmt.Sprintf(g.GameConfig["season_over_empty"], closing)
+	if champion != "" {
+		message = fmt.Sprintf(g.GameConfig["season_over"], closing, champion)
+	}
+	cli.Display(g.Writer, []string{
+		message,
+		g.GameConfig["newline"],
+		fmt.Sprintf(g.GameConfig["season_new"], seasons.Current.Number),
+		g.GameConfig["newline"],
+	})
+}
+
+// ShowScores displays the leaderboard of the season with the number, the
+// current one when zero, with its champion once it is over.
+func (g *Game) ShowScores(scoresStore *store.ScoresStore, number int) {
+	seasons := g.loadSeasons()
+	if number == 0 {
+		number = seasons.Current.Number
+	}
+
+	s, err := seasons.Find(number)
+	if err != nil {
+		g.displayError(err, []string{g.GameConfig["newline"]})
+		return
+	}
+
+	heading := fmt.Sprintf(g.GameConfig["season_scores"],
+		s.Number,
+		s.Started.Format(dateLayout),
+	)
+	if !s.Ended.IsZero() {
+		heading = fmt.Sprintf(g.GameConfig["season_scores_archived"],
+			s.Number,
+			s.Started.Format(dateLayout),
+			s.Ended.Format(dateLayout),
+		)
+	}
+
+	scoped := *scoresStore
+	scoped.Season = s.Number
+	messages := []string{
+		heading,
+		g.GameConfig["newline"],
+		g.leaderboard(scoped.Load().Rank(g.Ranking, 10)),
+	}
+
+	if s.Champion != "" {
+		messages = append(messages,
+			g.GameConfig["newline"],
+			fmt.Sprintf(g.GameConfig["season_champion"], s.Champion),
+			g.GameConfig["newline"],
+		)
+	}
+
+	cli.Display(g.Writer, append(messages, g.GameConfig["newline"]))
+}
+
+// ShowSeasons displays the current season, when it ends, and the champions
+// of the past seasons.
+func (g *Game) ShowSeasons() {
+	seasons := g.loadSeasons()
+
+	messages := []string{
+		fmt.Sprintf(g.GameConfig["season_current"],
+			seasons.Current.Number,
+			seasons.Current.Started.Format(dateLayout),
+		),
+		g.GameConfig["newline"],
+	}
+
+	if g.SeasonLength > 0 {
+		messages = append(messages,
+			fmt.Sprintf(g.GameConfig["season_ends"],
+				seasons.Current.Started.Add(g.SeasonLength).Format(dateLayout),
+			),
+			g.GameConfig["newline"],
+		)
+	}
+
+	messages = append(messages,
+		g.GameConfig["season_champions"],
+		g.GameConfig["newline"],
+		seasons.ChampionsString(),
+	)
+
+	cli.Display(g.Writer, append(messages, g.GameConfig["newline"]))
+}
+
+// loadSeasons loads the seasons, or the first season when they aren't
+// kept.
+func (g *Game) loadSeasons() season.Seasons {
+	if g.SeasonStore == nil {
+		return season.New(time.Now())
+	}
+	return g.SeasonStore.Load(time.Now())
+}
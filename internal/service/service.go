@@ -3,15 +3,31 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"time"
 
+	"github.com/go-number-guessing-game/internal/achievement"
+	"github.com/go-number-guessing-game/internal/adaptive"
+	"github.com/go-number-guessing-game/internal/analysis"
 	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
 	"github.com/go-number-guessing-game/internal/game"
 	"github.com/go-number-guessing-game/internal/parser"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/rating"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/go-number-guessing-game/internal/save"
+	"github.com/go-number-guessing-game/internal/scoring"
+	"github.com/go-number-guessing-game/internal/season"
+	"github.com/go-number-guessing-game/internal/setup"
 	"github.com/go-number-guessing-game/internal/store"
 	"github.com/go-number-guessing-game/internal/timer"
+	"github.com/go-number-guessing-game/internal/tournament"
+	"github.com/go-number-guessing-game/internal/tui"
 )
 
 // Game encapsulates the writer and input source interfaces for testing.
@@ -20,39 +36,235 @@ type Game struct {
 	Writer      io.Writer
 	InputSource cli.InputSource
 	GameConfig  map[string]string
+
+	// Screen draws the turns as full-screen frames instead of plain lines.
+	Screen *tui.Screen
+	// Theme styles results, hints, errors and the leaderboard.
+	Theme cli.Theme
+	// Accessible reads the leaderboard as sentences and announces the
+	// remaining attempts and possible range after every guess.
+	Accessible bool
+	// Reporter also receives the game flow as structured events.
+	Reporter Reporter
+	// Subscribers are notified of the engine events of every round.
+	Subscribers []engine.Subscriber
+
+	// SaveStore saves the round the player quits, to be resumed later.
+	SaveStore save.Store
+	// RecordStore records every finished round, to be replayed.
+	RecordStore record.Store
+
+	// GuessTime is the time to enter each guess, wasting the attempt
+	// otherwise, and GameTime the time to win the round. Zero plays
+	// without a deadline.
+	GuessTime time.Duration
+	GameTime  time.Duration
+
+	// Formula scores the points of won rounds.
+	Formula scoring.Formula
+	// Rounds builds the rounds of the game variant, with the hints and
+	// lies of their level.
+	Rounds setup.Builder
+
+	// Ranking orders the leaderboard shown with the :scores command.
+	Ranking store.Ranking
+	// RatingStore rates the player after every round.
+	RatingStore rating.Store
+	// AchievementStore keeps the badges earned, announced as they are.
+	AchievementStore achievement.Store
+	// TournamentStore keeps the tournament in progress.
+	TournamentStore tournament.Store
+	// SeasonStore keeps the seasons of the leaderboard, each lasting
+	// SeasonLength, or until a new one is started when zero.
+	SeasonStore  season.Store
+	SeasonLength time.Duration
+
+	// ProfileStore lets the player pick a profile once at startup, which
+	// plays every round as Profile on its preferred level.
+	ProfileStore profile.Store
+	Profile      *profile.Profile
+	// Locales holds the message configs of each locale, merged over
+	// GameConfig for the preferred locale of the profile.
+	Locales map[string]map[string]string
+	// Themes, when set, apply the preferred theme of the profile.
+	Themes map[string]string
+	// NameLimits bounds the player names, parser.DefaultNameLimits when
+	// zero.
+	NameLimits parser.NameLimits
+
+	// Commit shows a fairness commitment to the secret of every new round
+	// as it starts, and reveals the secret and its nonce at its end.
+	Commit bool
+	// Terminal tells that the writer is an interactive terminal, cleared
+	// between the turns of a tournament match.
+	Terminal bool
+
+	closed       bool
+	pending      chan readResult
+	pendingInput string
+}
+
+// readResult is the result of reading the input source, received from a
+// read started before a deadline.
+type readResult struct {
+	input string
+	err   error
+}
+
+// answerer is implemented by the errors of input sources whose inputs name
+// the prompt they answer, such as the JSON protocol, when an input read for
+// a prompt answers another one.
+type answerer interface {
+	Answer() (kind, input string)
+}
+
+// Reporter receives the prompts, errors and leaderboards of the game flow as
+// structured events, for front-ends such as bots that don't read the text
+// written to the writer. Prompt names the input asked for with one of the cli
+// input kinds. Turn results are received by subscribing to the engine.
+type Reporter interface {
+	Prompt(input string)
+	Leaderboard(scores store.Scores)
+	Error(err error)
+}
+
+type nopReporter struct{}
+
+func (nopReporter) Prompt(string)            {}
+func (nopReporter) Leaderboard(store.Scores) {}
+func (nopReporter) Error(error)              {}
+
+func (g *Game) reporter() Reporter {
+	if g.Reporter == nil {
+		return nopReporter{}
+	}
+	return g.Reporter
 }
 
 // PlayGame initiates the game with a random number and a store interface.
 // It manages user inputs, orchestrates game logic, and persists scores.
 // If the user guesses correctly, a new random number is generated.
 func (g *Game) PlayGame(randomNumber int, store store.Store) {
+	g.greet(g.Rounds.Bounds())
+
+	if g.ProfileStore != nil && g.Profile == nil {
+		g.PickProfile()
+	}
+
+	g.playRounds(randomNumber, store, nil)
+}
+
+// ResumeGame continues the round saved when the player last quit mid-game,
+// with the time already played, then goes on like PlayGame. The save is
+// deleted so that the round can't be resumed twice.
+func (g *Game) ResumeGame(store store.Store) {
+	resume, err := g.loadSave()
+	if err != nil {
+		g.greet(g.Rounds.Bounds())
+		g.displayError(err, []string{
+			g.GameConfig["newline"],
+		})
+		return
+	}
+	bounds := game.GameState{Min: resume.Min, Max: resume.Max}
+	g.greet(bounds.Bounds())
+
+	// The next rounds are played as the profile of the resumed player, or
+	// as the profile picked when the player has none.
+	if g.ProfileStore != nil && g.Profile == nil {
+		if p, ok := g.ProfileStore.Load().Find(resume.Player); ok {
+			g.usePreferences(p)
+		} else {
+			g.PickProfile()
+		}
+	}
+
+	g.playRounds(resume.RandomNumber, store, resume)
+}
+
+// greet welcomes the player to a round between low and high.
+func (g *Game) greet(low, high int) {
 	cli.Display(g.Writer, []string{
-		g.GameConfig["greeting"],
+		fmt.Sprintf(g.GameConfig["greeting"], low, high),
 		g.GameConfig["spacer"],
 	})
+}
 
+// playRounds plays rounds until the player stops, asking for the player and
+// difficulty of each round unless a first command is given.
+func (g *Game) playRounds(
+	randomNumber int,
+	store store.Store,
+	first engine.Command,
+) {
 gameLoop:
 	for {
-		cli.Display(g.Writer, g.GameConfig["player"])
-		player := g.getPlayerInput()
+		command := first
+		first = nil
+
+		if command == nil {
+			var player string
+			switch {
+			case g.Profile != nil:
+				player = g.Profile.DisplayName
+			case !g.closed:
+				cli.Display(g.Writer, g.GameConfig["player"])
+				player = g.getPlayerInput()
+			}
 
-		cli.Display(g.Writer, g.GameConfig["difficulty"])
-		level, maxAttempts := g.getUserDifficultyInput()
+			var level string
+			var maxAttempts int
+			switch {
+			case g.closed:
+			case g.Profile != nil && g.Profile.Level != "":
+				level, maxAttempts = g.preferredLevel()
+			default:
+				cli.Display(g.Writer, g.GameConfig["difficulty"])
+				level, maxAttempts = g.getUserDifficultyInput()
+			}
+
+			if g.closed {
+				cli.Display(g.Writer, []string{
+					g.GameConfig["bye"],
+					g.GameConfig["newline"],
+				})
+				break gameLoop
+			}
+
+			var results []adaptive.Result
+			if level == game.AdaptiveLevel {
+				results = g.adaptiveResults(store, player)
+			}
+			start, err := g.Rounds.Start(
+				player,
+				level,
+				maxAttempts,
+				randomNumber,
+				results,
+			)
+			if err != nil {
+				g.displayError(err, []string{g.GameConfig["newline"]})
+				break gameLoop
+			}
+			g.announceRound(start)
+			command = start
+		}
 
-		gameState := g.initGameState(level, maxAttempts, randomNumber)
-		found, attempts, time := g.playTurns(gameState)
+		result := g.playRound(command, store)
 
-		if found {
-			g.displayScores(player, level, attempts, time, store)
+		if result.found {
+			g.displayScores(result.scores)
 		}
 
-		playAgain := g.getPlayAgainInput()
+		playAgain := !result.quit && g.getPlayAgainInput()
 		switch {
-		case playAgain && found:
-			randomNumber = game.NewRandomNumber()
+		case playAgain && (result.found || result.revealed):
+			// A new number is drawn in the range of the next round, once
+			// the number was found or shown.
+			randomNumber = 0
 			continue gameLoop
 
-		case playAgain && !found:
+		case playAgain:
 			continue gameLoop
 
 		default:
@@ -65,83 +277,491 @@ gameLoop:
 	}
 }
 
-func (g *Game) initGameState(
-	level string,
-	maxAttempts,
-	randomNumber int,
-) game.GameState {
-	return game.GameState{
-		Level:        level,
-		MaxAttempts:  maxAttempts,
-		RandomNumber: randomNumber,
-		Turns:        game.Turns{},
+// announceRound introduces the rules of the variant of the new round, its
+// tuned range when it is adaptive, and the lies it may tell.
+func (g *Game) announceRound(start engine.Start) {
+	bounds := game.GameState{Min: start.Min, Max: start.Max}
+	low, high := bounds.Bounds()
+
+	var messages []string
+	switch {
+	case start.Variant == game.CodeVariant:
+		messages = append(messages, fmt.Sprintf(g.GameConfig["code"],
+			bounds.CodeDigits(),
+			start.MaxAttempts,
+		))
+	case start.Level == game.AdaptiveLevel:
+		messages = append(messages, fmt.Sprintf(g.GameConfig["adaptive"],
+			low,
+			high,
+			start.MaxAttempts,
+		))
+	}
+	if start.MaxLies > 0 {
+		messages = append(messages, fmt.Sprintf(g.GameConfig["lies"], start.MaxLies))
+	}
+	switch start.Variant {
+	case game.MultiVariant:
+		messages = append(messages, fmt.Sprintf(g.GameConfig["multi"],
+			len(start.Secrets),
+			low,
+			high,
+			start.MaxAttempts,
+		))
+	case game.DriftVariant:
+		messages = append(messages, fmt.Sprintf(g.GameConfig["drift"], game.DriftStep))
+	}
+
+	for _, message := range messages {
+		cli.Display(g.Writer, []string{message, g.GameConfig["spacer"]})
 	}
 }
 
-func (g *Game) playTurns(gameState game.GameState) (bool, int, time.Duration) {
-	var found bool
-	var attempts int
-	var gameTime time.Duration
+// adaptiveResults returns the adaptive rounds of the player, with the lost
+// ones when the rounds are recorded, and the won ones of the scores
+// otherwise.
+func (g *Game) adaptiveResults(
+	gameStore store.Store,
+	player string,
+) []adaptive.Result {
+	if g.RecordStore != nil {
+		return adaptive.GameResults(g.RecordStore.Load(), player)
+	}
+	return adaptive.ScoreResults(gameStore.Load(), player)
+}
 
-	gameTimer := timer.NewGameTimer()
-	gameTimer.Start()
+// roundResult subscribes to the engine to summarize how a round ended, for
+// scoring, for deciding whether to ask to play again and for tournaments.
+// Left tells that the player quit once the round started.
+type roundResult struct {
+	found    bool
+	revealed bool
+	quit     bool
+	left     bool
+	attempts int
+	time     time.Duration
+	scores   store.Scores
+}
 
-turnLoop:
-	for {
-		if gameState.NoMoreAttempts() {
+// Notify records the end of the round.
+func (r *roundResult) Notify(event engine.Event) {
+	switch e := event.(type) {
+	case engine.GameWon:
+		r.found = true
+		r.attempts, r.time = e.Attempts, e.Time
+	case engine.GameLost:
+		r.revealed = e.Revealed
+		r.attempts, r.time = e.Attempts, e.Time
+	}
+}
+
+// scoreRecorder subscribes to the engine to add a score for every won
+// round, with the points of the formula and the profile of the player,
+// keeping the resulting top scores and error for the front-end.
+type scoreRecorder struct {
+	store     store.Store
+	formula   scoring.Formula
+	profileID int
+	scores    store.Scores
+	err       error
+}
+
+// Notify adds a score when the event is a won round.
+func (r *scoreRecorder) Notify(event engine.Event) {
+	won, ok := event.(engine.GameWon)
+	if !ok {
+		return
+	}
+
+	r.scores, r.err = r.store.Add(store.Score{
+		Player:      won.Player,
+		Level:       won.Level,
+		Variant:     won.Variant,
+		Attempts:    won.Attempts,
+		Time:        won.Time,
+		Points:      r.formula.Points(won),
+		MaxAttempts: won.MaxAttempts,
+		Min:         won.Min,
+		Max:         won.Max,
+		Efficiency:  won.Efficiency,
+		ProfileID:   r.profileID,
+	})
+}
+
+// recorders are the subscribers keeping a round in the stores of the game,
+// those without a store being nil.
+type recorders struct {
+	scores    *scoreRecorder
+	recording *record.Recorder
+	rater     *rating.Recorder
+	badges    *achievement.Recorder
+}
+
+// subscribe subscribes the view, the result, the recorders of the stores
+// and any extra subscribers to the engine of the round played as the
+// profile.
+func (g *Game) subscribe(
+	round *engine.Engine,
+	result *roundResult,
+	gameStore store.Store,
+	profileID int,
+) recorders {
+	r := recorders{
+		scores: &scoreRecorder{
+			store:     gameStore,
+			formula:   g.Formula,
+			profileID: profileID,
+		},
+	}
+	round.Subscribe(&view{game: g, engine: round})
+	round.Subscribe(r.scores)
+	round.Subscribe(result)
+	if g.RecordStore != nil {
+		r.recording = &record.Recorder{Store: g.RecordStore, Engine: round}
+		round.Subscribe(r.recording)
+	}
+	if g.RatingStore != nil {
+		r.rater = &rating.Recorder{
+			Store:     g.RatingStore,
+			System:    rating.DefaultSystem,
+			ProfileID: profileID,
+		}
+		round.Subscribe(r.rater)
+	}
+	if g.AchievementStore != nil {
+		r.badges = &achievement.Recorder{
+			Store:  g.AchievementStore,
+			Engine: round,
+		}
+		round.Subscribe(r.badges)
+	}
+	for _, subscriber := range g.Subscribers {
+		round.Subscribe(subscriber)
+	}
+
+	return r
+}
+
+// displayRecorded announces the recording, rating and badges of the
+// finished round.
+func (g *Game) displayRecorded(r recorders) {
+	if r.recording != nil && r.recording.Err == nil {
+		cli.Display(g.Writer, []string{
+			fmt.Sprintf(g.GameConfig["recorded"], r.recording.Game.ID),
+			g.GameConfig["newline"],
+		})
+	}
+
+	if r.rater != nil && r.rater.Err == nil && r.rater.Entry != (rating.Entry{}) {
+		cli.Display(g.Writer, []string{
+			fmt.Sprintf(g.GameConfig["rated"],
+				int(math.Round(r.rater.Entry.Rating)),
+				int(math.Round(r.rater.Entry.Change)),
+			),
+			g.GameConfig["newline"],
+		})
+	}
+
+	if r.badges != nil && r.badges.Err == nil {
+		for _, badge := range r.badges.Earned {
 			cli.Display(g.Writer, []string{
-				g.GameConfig["max_attempts"],
+				fmt.Sprintf(g.GameConfig["badge"],
+					badge.Name,
+					badge.Description,
+				),
 				g.GameConfig["newline"],
 			})
-			gameTimer.End()
-			break turnLoop
 		}
+	}
+}
+
+// playRound starts or resumes an engine for the round, subscribes to it,
+// and feeds it the commands read at the guess prompt until the round is
+// over or the player quits.
+func (g *Game) playRound(
+	start engine.Command,
+	gameStore store.Store,
+) roundResult {
+	var result roundResult
+
+	round := g.Rounds.Engine()
+	recorded := g.subscribe(round, &result, gameStore, g.profileID(start))
 
-		guessNumber := g.getUserGuessNumberInput()
-		err := gameState.PlayTurn(game.Turn{GuessNumber: guessNumber})
+	// New rounds commit to their secret, shown as they start and revealed
+	// at their end, so that the player can verify it never changed.
+	if s, ok := start.(engine.Start); ok && g.Commit && s.Commitment.Hash == "" {
+		secret := game.GameState{
+			RandomNumber: s.RandomNumber,
+			Variant:      s.Variant,
+			Secrets:      s.Secrets,
+			Seed:         s.Seed,
+		}
+		commitment, err := fairness.Commit(secret.Secret())
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
-				g.GameConfig["newline"],
-			})
+			g.displayError(err, []string{g.GameConfig["newline"]})
+			result.quit = true
+			return result
 		}
+		s.Commitment = commitment
+		start = s
+	}
+
+	if err := round.Handle(start); err != nil {
+		g.displayError(err, []string{
+			g.GameConfig["newline"],
+		})
+		result.quit = true
+		return result
+	}
+
+	if g.Screen != nil {
+		g.Screen.StartClock(round.Elapsed)
+		defer g.Screen.StopClock()
+	}
+
+	clock := &timer.DefaultTimer{}
+	var guessDeadline, gameDeadline timer.Deadline
+	if g.GameTime > 0 {
+		gameDeadline = timer.NewDeadline(clock, g.GameTime-round.Elapsed())
+	}
+	attempts := -1
+
+	for !round.Over() {
+		// The guess deadline restarts after every attempt, but not after
+		// commands that only read the round.
+		gameState := round.State()
+		if g.GuessTime > 0 && gameState.GetAttempts() != attempts {
+			attempts = gameState.GetAttempts()
+			guessDeadline = timer.NewDeadline(clock, g.GuessTime)
+		}
+
+		low, high := gameState.Bounds()
+		input, timeout := g.getUserGuessInput(
+			low,
+			high,
+			guessDeadline,
+			gameDeadline,
+		)
+		if timeout != nil {
+			_ = round.Handle(timeout)
+			continue
+		}
+
+		var err error
+		switch input.Command {
+		case "":
+			err = round.Handle(engine.Guess{Number: input.Number})
+
+		case parser.CommandHint:
+			err = round.Handle(engine.SpendHint{})
 
-		lastTurn, _ := gameState.GetLastTurn()
+		case parser.CommandGiveUp:
+			err = round.Handle(engine.GiveUp{})
 
-		switch *lastTurn.Outcome {
-		case 1:
+		case parser.CommandQuit:
+			g.saveRound(round)
+			result.quit, result.left = true, true
+			result.time = round.Elapsed()
+			return result
+
+		default:
+			g.playCommand(input.Command, round.State(), gameStore)
+		}
+
+		var noMoreClues *engine.NoMoreCluesError
+		switch {
+		case errors.As(err, &noMoreClues):
 			cli.Display(g.Writer, []string{
-				fmt.Sprintf(g.GameConfig["greater"], guessNumber),
-				g.GameConfig["newline"],
-				g.giveHint(lastTurn),
+				g.GameConfig["no_clue"],
 				g.GameConfig["spacer"],
 			})
-			continue turnLoop
 
-		case -1:
-			cli.Display(g.Writer, []string{
-				fmt.Sprintf(g.GameConfig["less"], guessNumber),
+		case err != nil:
+			g.displayError(err, []string{
 				g.GameConfig["newline"],
-				g.giveHint(lastTurn),
-				g.GameConfig["spacer"],
 			})
-			continue turnLoop
+		}
+	}
 
-		case 0:
-			found = true
-			gameTime = gameTimer.End()
-			stringTime := gameTime.String()
-			attempts = gameState.GetAttempts()
+	g.displayAnalysis(round.State(), result.found)
+	g.displayRecorded(recorded)
 
-			cli.Display(g.Writer, []string{
-				fmt.Sprintf(g.GameConfig["equal"], stringTime, attempts),
-				g.GameConfig["newline"],
-			})
-			break turnLoop
+	result.scores = recorded.scores.scores
+	return result
+}
+
+// displayAnalysis shows the efficiency report of the finished round: the
+// guesses that couldn't be the number and, when the number was found, the
+// guesses a binary search would have needed and the efficiency score. They
+// are left out of lost rounds, whose number is kept for the next round.
+func (g *Game) displayAnalysis(gameState game.GameState, found bool) {
+	report := analysis.Analyze(gameState)
+
+	var messages []string
+	for _, guess := range report.Flagged {
+		message := fmt.Sprintf(g.GameConfig["analysis_outside"],
+			guess.Turn,
+			guess.Number,
+			guess.Low,
+			guess.High,
+		)
+		if guess.Repeated {
+			message = fmt.Sprintf(g.GameConfig["analysis_repeated"],
+				guess.Turn,
+				guess.Number,
+			)
+		}
+		messages = append(messages, message, g.GameConfig["newline"])
+	}
+
+	if found && report.Optimal > 0 {
+		messages = append(messages,
+			fmt.Sprintf(g.GameConfig["analysis_optimal"],
+				report.Played,
+				report.Optimal,
+			),
+			g.GameConfig["newline"],
+			fmt.Sprintf(g.GameConfig["analysis_efficiency"], report.Efficiency),
+			g.GameConfig["newline"],
+		)
+	}
+
+	if len(messages) == 0 {
+		return
+	}
+
+	cli.Display(g.Writer, append(
+		[]string{g.GameConfig["analysis"], g.GameConfig["newline"]},
+		messages...,
+	))
+}
+
+// saveRound saves the round left mid-game when the game has a save store.
+func (g *Game) saveRound(round *engine.Engine) {
+	if g.SaveStore == nil {
+		return
+	}
+
+	saved, err := save.NewGame(round.Suspend())
+	if err == nil {
+		err = g.SaveStore.Save(saved)
+	}
+	if err != nil {
+		g.displayError(err, []string{
+			g.GameConfig["newline"],
+		})
+		return
+	}
+
+	cli.Display(g.Writer, []string{
+		g.GameConfig["saved"],
+		g.GameConfig["newline"],
+	})
+}
+
+// loadSave loads the saved round and deletes it.
+func (g *Game) loadSave() (engine.Resume, error) {
+	if g.SaveStore == nil {
+		return engine.Resume{}, save.NewNoSaveError()
+	}
+
+	saved, err := g.SaveStore.Load()
+	if err != nil {
+		return engine.Resume{}, err
+	}
+
+	resume, err := saved.Resume()
+	if err != nil {
+		return engine.Resume{}, err
+	}
+
+	return resume, g.SaveStore.Delete()
+}
+
+// playCommand runs an in-game command that only reads the round, without
+// playing a turn or spending an attempt.
+func (g *Game) playCommand(
+	command parser.Command,
+	gameState game.GameState,
+	gameStore store.Store,
+) {
+	switch command {
+	case parser.CommandHistory:
+		messages := []string{g.GameConfig["history_empty"]}
+		if len(gameState.Turns) > 0 {
+			messages = g.history(gameState.Turns)
+		}
+		cli.Display(g.Writer, append(messages, g.GameConfig["spacer"]))
+
+	case parser.CommandRange:
+		low, high := gameState.PossibleRange()
+		cli.Display(g.Writer, []string{
+			fmt.Sprintf(g.GameConfig["range"], low, high),
+			g.GameConfig["spacer"],
+		})
+
+	case parser.CommandScores:
+		cli.Display(g.Writer, []string{
+			g.leaderboard(gameStore.Load().Rank(g.Ranking, 10)),
+			g.GameConfig["spacer"],
+		})
+
+	case parser.CommandHelp:
+		cli.Display(g.Writer, []string{
+			g.GameConfig["help"],
+			g.GameConfig["spacer"],
+		})
+	}
+}
+
+// leaderboard formats the scores as linear sentences in accessible mode, and
+// as a table with a styled header otherwise.
+func (g *Game) leaderboard(scores store.Scores) string {
+	if g.Accessible {
+		return scores.Sentences()
+	}
+
+	return scores.Render(g.Theme.Codes("header"))
+}
+
+// displayError displays the error followed by the messages, and reports it.
+func (g *Game) displayError(err error, messages []string) {
+	g.reporter().Error(err)
+	cli.Display(g.Writer, append(
+		[]string{g.Theme.Paint("error", err.Error())},
+		messages...,
+	))
+}
+
+func (g *Game) history(turns game.Turns) []string {
+	var messages []string
+
+	for i, turn := range turns {
+		var result string
+		switch *turn.Outcome {
+		case game.Greater:
+			result = fmt.Sprintf(g.GameConfig["greater"], turn.GuessNumber)
+		case game.Less:
+			result = fmt.Sprintf(g.GameConfig["less"], turn.GuessNumber)
+		case game.Mismatch:
+			result = fmt.Sprintf(g.GameConfig["mismatch"],
+				turn.GuessNumber,
+				turn.Bulls,
+				turn.Cows,
+			)
+		case game.Hit:
+			result = fmt.Sprintf(g.GameConfig["hit"], turn.GuessNumber)
+		default:
+			result = fmt.Sprint(turn.GuessNumber)
 		}
+
+		messages = append(messages,
+			fmt.Sprintf(g.GameConfig["history_turn"], i+1, result),
+			g.GameConfig["newline"],
+		)
 	}
 
-	return found, attempts, gameTime
+	return messages
 }
 
 func (g *Game) getPlayerInput() string {
@@ -149,20 +769,27 @@ func (g *Game) getPlayerInput() string {
 
 playerLoop:
 	for {
-		input, err := g.InputSource.NextPlayerInput()
+		g.reporter().Prompt(cli.InputPlayer)
+		input, err := g.nextInput(
+			cli.InputPlayer,
+			g.InputSource.NextPlayerInput,
+			timer.Deadline{},
+		)
+		if errors.Is(err, io.EOF) {
+			g.closed = true
+			return ""
+		}
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 				g.GameConfig["player"],
 			})
 			continue playerLoop
 		}
 
-		player, err = parser.ParsePlayerInput(input)
+		player, err = g.NameLimits.Parse(input)
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 				g.GameConfig["player"],
 			})
@@ -179,10 +806,18 @@ func (g *Game) getUserDifficultyInput() (string, int) {
 
 difficultyLoop:
 	for {
-		input, err := g.InputSource.NextDifficultyInput()
+		g.reporter().Prompt(cli.InputDifficulty)
+		input, err := g.nextInput(
+			cli.InputDifficulty,
+			g.InputSource.NextDifficultyInput,
+			timer.Deadline{},
+		)
+		if errors.Is(err, io.EOF) {
+			g.closed = true
+			return "", 0
+		}
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 				g.GameConfig["difficulty"],
 			})
@@ -191,8 +826,7 @@ difficultyLoop:
 
 		level, maxAttempts, err = parser.ParseDifficultyInput(input)
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 				g.GameConfig["difficulty"],
 			})
@@ -208,25 +842,54 @@ difficultyLoop:
 	return level, maxAttempts
 }
 
-func (g *Game) getUserGuessNumberInput() int {
-	var guessNumber int
+// getUserGuessInput reads the next guess or command. In time-attack mode,
+// it shows the countdown at the prompt and returns the timeout command of
+// the first deadline passing before the input is entered. Guesses must be
+// between low and high, the range of the round.
+func (g *Game) getUserGuessInput(
+	low, high int,
+	guessDeadline, gameDeadline timer.Deadline,
+) (parser.GuessInput, engine.Command) {
+	var guessInput parser.GuessInput
+
+	deadline := guessDeadline
+	if deadline.IsZero() ||
+		!gameDeadline.IsZero() && gameDeadline.At.Before(deadline.At) {
+		deadline = gameDeadline
+	}
 
 guessNumberLoop:
 	for {
-		cli.Display(g.Writer, g.GameConfig["guess"])
-		input, err := g.InputSource.NextGuessNumberInput()
+		cli.Display(g.Writer, g.countdown(guessDeadline, gameDeadline)+
+			g.GameConfig["guess"],
+		)
+		g.reporter().Prompt(cli.InputGuess)
+		input, err := g.nextInput(
+			cli.InputGuess,
+			g.InputSource.NextGuessNumberInput,
+			deadline,
+		)
+
+		var expired *timer.ExpiredError
+		switch {
+		case errors.As(err, &expired) && gameDeadline.Expired():
+			return parser.GuessInput{}, engine.GameTimeout{}
+		case errors.As(err, &expired):
+			return parser.GuessInput{}, engine.GuessTimeout{}
+		case errors.Is(err, io.EOF):
+			g.closed = true
+			return parser.GuessInput{Command: parser.CommandQuit}, nil
+		}
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 			})
 			continue guessNumberLoop
 		}
 
-		guessNumber, err = parser.ParseGuessNumberInput(input)
+		guessInput, err = parser.ParseGuessInputBetween(input, low, high)
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 			})
 			continue guessNumberLoop
@@ -234,7 +897,78 @@ guessNumberLoop:
 		break guessNumberLoop
 	}
 
-	return guessNumber
+	return guessInput, nil
+}
+
+// countdown returns the seconds left before the set deadlines, shown ahead
+// of the guess prompt.
+func (g *Game) countdown(guessDeadline, gameDeadline timer.Deadline) string {
+	var countdown string
+
+	if !guessDeadline.IsZero() {
+		countdown += fmt.Sprintf(g.GameConfig["countdown_guess"],
+			seconds(guessDeadline.Remaining()),
+		)
+	}
+	if !gameDeadline.IsZero() {
+		countdown += fmt.Sprintf(g.GameConfig["countdown_game"],
+			seconds(gameDeadline.Remaining()),
+		)
+	}
+
+	return countdown
+}
+
+// seconds rounds the duration up to whole seconds.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// nextInput reads the next input of the cli input kind, giving up with an
+// ExpiredError when the deadline passes first. The read then goes on in the
+// background so that the source is never read concurrently, and its result
+// answers the next prompt, whatever its kind: once a round timed out, the
+// next line entered answers the play-again prompt shown.
+func (g *Game) nextInput(
+	input string,
+	next func() (string, error),
+	deadline timer.Deadline,
+) (string, error) {
+	var expired <-chan time.Time
+	if !deadline.IsZero() {
+		deadlineTimer := time.NewTimer(deadline.Remaining())
+		defer deadlineTimer.Stop()
+		expired = deadlineTimer.C
+	}
+
+	for {
+		if g.pending == nil {
+			if deadline.IsZero() {
+				return next()
+			}
+
+			pending := make(chan readResult, 1)
+			go func() {
+				read, err := next()
+				pending <- readResult{input: read, err: err}
+			}()
+			g.pending, g.pendingInput = pending, input
+		}
+
+		select {
+		case result := <-g.pending:
+			g.pending = nil
+			var answer answerer
+			if g.pendingInput != input && errors.As(result.err, &answer) {
+				if kind, text := answer.Answer(); kind == input {
+					return text, nil
+				}
+			}
+			return result.input, result.err
+		case <-expired:
+			return "", timer.NewExpiredError()
+		}
+	}
 }
 
 func (g *Game) getPlayAgainInput() bool {
@@ -243,11 +977,19 @@ func (g *Game) getPlayAgainInput() bool {
 playAgainLoop:
 	for {
 		cli.Display(g.Writer, g.GameConfig["again"])
+		g.reporter().Prompt(cli.InputPlayAgain)
 
-		input, err := g.InputSource.NextPlayAgainInput()
+		input, err := g.nextInput(
+			cli.InputPlayAgain,
+			g.InputSource.NextPlayAgainInput,
+			timer.Deadline{},
+		)
+		if errors.Is(err, io.EOF) {
+			g.closed = true
+			return false
+		}
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 			})
 			continue playAgainLoop
@@ -255,8 +997,7 @@ playAgainLoop:
 
 		playAgain, err = parser.ParsePlayAgainInput(input)
 		if err != nil {
-			cli.Display(g.Writer, []string{
-				err.Error(),
+			g.displayError(err, []string{
 				g.GameConfig["spacer"],
 			})
 			continue playAgainLoop
@@ -267,45 +1008,24 @@ playAgainLoop:
 	return playAgain
 }
 
-func (g *Game) giveHint(lastTurn game.Turn) string {
-	var hint string
+func (g *Game) displayScores(scores store.Scores) {
+	g.reporter().Leaderboard(scores)
+	if g.Screen != nil {
+		g.Screen.ShowScores(g.leaderboard(scores))
+		return
+	}
 
-	switch {
-	case *lastTurn.Difference == 1:
-		hint = g.GameConfig["very_close_1"]
-	case *lastTurn.Difference == 2:
-		hint = g.GameConfig["very_close_2"]
-	case *lastTurn.Difference == 3:
-		hint = g.GameConfig["very_close_3"]
-	case *lastTurn.Difference == 4:
-		hint = g.GameConfig["close_1"]
-	case *lastTurn.Difference == 5:
-		hint = g.GameConfig["close_2"]
-	case *lastTurn.Difference > 5 && *lastTurn.Difference < 10:
-		hint = g.GameConfig["far"]
-	default:
-		hint = g.GameConfig["very_far"]
-	}
-
-	return hint
-}
-
-func (g *Game) displayScores(player string,
-	level string,
-	attempts int,
-	time time.Duration,
-	gameStore store.Store,
-) {
-	score := store.Score{
-		Player:   player,
-		Level:    level,
-		Attempts: attempts,
-		Time:     time,
+	if g.Accessible {
+		cli.Display(g.Writer, []string{
+			g.leaderboard(scores),
+			g.GameConfig["newline"],
+		})
+		return
 	}
-	scores, _ := gameStore.Add(score)
+
 	cli.Display(g.Writer, []string{
 		g.GameConfig["spacer"],
-		scores.String(),
+		g.leaderboard(scores),
 		g.GameConfig["spacer"],
 	})
 }
@@ -3,14 +3,32 @@ package service_test
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-number-guessing-game/internal/achievement"
+	"github.com/go-number-guessing-game/internal/cli"
 	"github.com/go-number-guessing-game/internal/config"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/go-number-guessing-game/internal/oracle"
 	"github.com/go-number-guessing-game/internal/parser"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/rating"
+	"github.com/go-number-guessing-game/internal/record"
+	"github.com/go-number-guessing-game/internal/save"
+	"github.com/go-number-guessing-game/internal/scoring"
+	"github.com/go-number-guessing-game/internal/season"
 	"github.com/go-number-guessing-game/internal/service"
 	"github.com/go-number-guessing-game/internal/store"
+	"github.com/go-number-guessing-game/internal/tournament"
+	"github.com/go-number-guessing-game/internal/tui"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,26 +41,114 @@ var (
 
 func TestIntegrationGameConfig(t *testing.T) {
 	wantSet := map[string]struct{}{
-		"greeting":     {},
-		"player":       {},
-		"difficulty":   {},
-		"level":        {},
-		"guess":        {},
-		"greater":      {},
-		"less":         {},
-		"equal":        {},
-		"max_attempts": {},
-		"very_close_1": {},
-		"very_close_2": {},
-		"very_close_3": {},
-		"close_1":      {},
-		"close_2":      {},
-		"far":          {},
-		"very_far":     {},
-		"again":        {},
-		"bye":          {},
-		"newline":      {},
-		"spacer":       {},
+		"greeting":               {},
+		"player":                 {},
+		"difficulty":             {},
+		"level":                  {},
+		"guess":                  {},
+		"greater":                {},
+		"less":                   {},
+		"mismatch":               {},
+		"nearest_greater":        {},
+		"nearest_less":           {},
+		"hit":                    {},
+		"secrets_left":           {},
+		"equal":                  {},
+		"max_attempts":           {},
+		"very_close_1":           {},
+		"very_close_2":           {},
+		"very_close_3":           {},
+		"close_1":                {},
+		"close_2":                {},
+		"far":                    {},
+		"very_far":               {},
+		"hot_first":              {},
+		"warmer":                 {},
+		"colder":                 {},
+		"as_warm":                {},
+		"hint_even":              {},
+		"hint_odd":               {},
+		"hint_multiple":          {},
+		"hint_not_multiple":      {},
+		"digit_sum_greater":      {},
+		"digit_sum_less":         {},
+		"digit_sum_equal":        {},
+		"again":                  {},
+		"bye":                    {},
+		"newline":                {},
+		"spacer":                 {},
+		"tui_title":              {},
+		"tui_range":              {},
+		"tui_attempts":           {},
+		"tui_time":               {},
+		"tui_history":            {},
+		"tui_turn":               {},
+		"help":                   {},
+		"history_empty":          {},
+		"history_turn":           {},
+		"range":                  {},
+		"no_clue":                {},
+		"gave_up":                {},
+		"saved":                  {},
+		"resumed":                {},
+		"recorded":               {},
+		"replay_intro":           {},
+		"replay_step":            {},
+		"replay_end":             {},
+		"countdown_guess":        {},
+		"countdown_game":         {},
+		"guess_expired":          {},
+		"time_up":                {},
+		"rated":                  {},
+		"stats_ratings":          {},
+		"stats_history":          {},
+		"code":                   {},
+		"multi":                  {},
+		"secrets":                {},
+		"drift":                  {},
+		"adaptive":               {},
+		"badge":                  {},
+		"badges_players":         {},
+		"badges_player":          {},
+		"analysis":               {},
+		"analysis_outside":       {},
+		"analysis_repeated":      {},
+		"analysis_optimal":       {},
+		"analysis_efficiency":    {},
+		"lies":                   {},
+		"lies_revealed":          {},
+		"lie":                    {},
+		"simulation":             {},
+		"tournament_match":       {},
+		"tournament_turn":        {},
+		"tournament_winner":      {},
+		"tournament_draw":        {},
+		"tournament_left":        {},
+		"tournament_bracket":     {},
+		"tournament_standings":   {},
+		"tournament_champion":    {},
+		"season_over":            {},
+		"season_over_empty":      {},
+		"season_new":             {},
+		"season_scores":          {},
+		"season_scores_archived": {},
+		"season_champion":        {},
+		"season_current":         {},
+		"season_ends":            {},
+		"season_champions":       {},
+		"profiles":               {},
+		"profile_pick":           {},
+		"profile_created":        {},
+		"profile_welcome":        {},
+		"profile_level":          {},
+		"profile_updated":        {},
+		"commitment":             {},
+		"reveal":                 {},
+		"verify_fair":            {},
+		"verify_valid":           {},
+		"verify_unfair":          {},
+		"verify_none":            {},
+		"announce":               {},
 	}
 
 	gotSet := make(map[string]struct{})
@@ -52,6 +158,16 @@ func TestIntegrationGameConfig(t *testing.T) {
 	assert.Equal(t, wantSet, gotSet)
 }
 
+func TestIntegrationAccessibleConfig(t *testing.T) {
+	t.Run("override only existing messages", func(t *testing.T) {
+		overrides := config.LoadConfig("yaml", "../../configs/accessible.yaml")
+
+		for k := range overrides {
+			assert.Contains(t, gameConfig, k)
+		}
+	})
+}
+
 func TestIntegrationGamePlay(t *testing.T) {
 	t.Run("user success", func(t *testing.T) {
 		testCases := []struct {
@@ -68,7 +184,7 @@ func TestIntegrationGamePlay(t *testing.T) {
 					PlayAgainInput:    []string{"2"},
 				},
 				outputStrings: []string{
-					gameConfig["greeting"],
+					fmt.Sprintf(gameConfig["greeting"], 1, 100),
 					gameConfig["spacer"],
 					gameConfig["player"],
 					gameConfig["difficulty"],
@@ -87,6 +203,12 @@ func TestIntegrationGamePlay(t *testing.T) {
 					gameConfig["guess"],
 					fmt.Sprintf(gameConfig["equal"], "0s", 3),
 					gameConfig["newline"],
+					gameConfig["analysis"],
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_optimal"], 3, 1),
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_efficiency"], 33),
+					gameConfig["newline"],
 					gameConfig["spacer"],
 					fakeScores,
 					gameConfig["spacer"],
@@ -104,7 +226,7 @@ func TestIntegrationGamePlay(t *testing.T) {
 					PlayAgainInput:    []string{"2"},
 				},
 				outputStrings: []string{
-					gameConfig["greeting"],
+					fmt.Sprintf(gameConfig["greeting"], 1, 100),
 					gameConfig["spacer"],
 					gameConfig["player"],
 					gameConfig["difficulty"],
@@ -133,6 +255,12 @@ func TestIntegrationGamePlay(t *testing.T) {
 					gameConfig["guess"],
 					fmt.Sprintf(gameConfig["equal"], "0s", 5),
 					gameConfig["newline"],
+					gameConfig["analysis"],
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_optimal"], 5, 1),
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_efficiency"], 20),
+					gameConfig["newline"],
 					gameConfig["spacer"],
 					fakeScores,
 					gameConfig["spacer"],
@@ -153,7 +281,7 @@ func TestIntegrationGamePlay(t *testing.T) {
 					PlayAgainInput: []string{"2"},
 				},
 				outputStrings: []string{
-					gameConfig["greeting"],
+					fmt.Sprintf(gameConfig["greeting"], 1, 100),
 					gameConfig["spacer"],
 					gameConfig["player"],
 					gameConfig["difficulty"],
@@ -207,6 +335,12 @@ func TestIntegrationGamePlay(t *testing.T) {
 					gameConfig["guess"],
 					fmt.Sprintf(gameConfig["equal"], "0s", 10),
 					gameConfig["newline"],
+					gameConfig["analysis"],
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_optimal"], 10, 1),
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_efficiency"], 10),
+					gameConfig["newline"],
 					gameConfig["spacer"],
 					fakeScores,
 					gameConfig["spacer"],
@@ -264,7 +398,7 @@ func TestIntegrationGamePlay(t *testing.T) {
 
 				var wantWriter strings.Builder
 				for _, s := range []string{
-					gameConfig["greeting"],
+					fmt.Sprintf(gameConfig["greeting"], 1, 100),
 					gameConfig["spacer"],
 					gameConfig["player"],
 					gameConfig["difficulty"],
@@ -287,6 +421,10 @@ func TestIntegrationGamePlay(t *testing.T) {
 					gameConfig["spacer"],
 					gameConfig["max_attempts"],
 					gameConfig["newline"],
+					gameConfig["analysis"],
+					gameConfig["newline"],
+					fmt.Sprintf(gameConfig["analysis_outside"], 3, 52, 50, 50),
+					gameConfig["newline"],
 					gameConfig["again"],
 				} {
 					wantWriter.WriteString(s)
@@ -311,6 +449,12 @@ func TestIntegrationGamePlay(t *testing.T) {
 						gameConfig["guess"],
 						fmt.Sprintf(gameConfig["equal"], "0s", 3),
 						gameConfig["newline"],
+						gameConfig["analysis"],
+						gameConfig["newline"],
+						fmt.Sprintf(gameConfig["analysis_optimal"], 3, 1),
+						gameConfig["newline"],
+						fmt.Sprintf(gameConfig["analysis_efficiency"], 33),
+						gameConfig["newline"],
 						gameConfig["spacer"],
 						fakeScores,
 						gameConfig["spacer"],
@@ -360,13 +504,13 @@ func TestIntegrationGamePlay(t *testing.T) {
 			},
 			{
 				description:      "greater than max difficulty",
-				invalidInput:     "4",
-				wantErrorMessage: fmt.Sprintf(parser.NumberRangeMessage, 1, 3),
+				invalidInput:     "5",
+				wantErrorMessage: fmt.Sprintf(parser.NumberRangeMessage, 1, 4),
 			},
 			{
 				description:      "less than min difficulty",
 				invalidInput:     "0",
-				wantErrorMessage: fmt.Sprintf(parser.NumberRangeMessage, 1, 3),
+				wantErrorMessage: fmt.Sprintf(parser.NumberRangeMessage, 1, 4),
 			},
 		}
 
@@ -482,84 +626,1629 @@ func TestIntegrationGamePlay(t *testing.T) {
 	})
 }
 
-func initGame(mockInputSource *MockInputSource) (*bytes.Buffer, service.Game) {
-	gotWriter := &bytes.Buffer{}
-	game := service.Game{
-		InputSource: mockInputSource,
-		GameConfig:  gameConfig,
-		Writer:      gotWriter,
-	}
-	return gotWriter, game
+func TestIntegrationGamePlayCommands(t *testing.T) {
+	t.Run("run commands without playing turns", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			command     string
+			want        []string
+		}{
+			{
+				description: "history",
+				command:     ":history",
+				want: []string{
+					fmt.Sprintf(gameConfig["history_turn"], 1,
+						fmt.Sprintf(gameConfig["greater"], 25),
+					),
+				},
+			},
+			{
+				description: "range",
+				command:     ":range",
+				want:        []string{fmt.Sprintf(gameConfig["range"], 26, 100)},
+			},
+			{
+				description: "scores",
+				command:     ":scores",
+				want:        []string{fakeScores},
+			},
+			{
+				description: "help",
+				command:     ":HELP",
+				want:        []string{gameConfig["help"]},
+			},
+			{
+				description: "unknown command",
+				command:     ":unknown",
+				want: []string{
+					fmt.Sprintf(parser.ParseCommandMessage, ":unknown"),
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				mockInputSource := &MockInputSource{
+					PlayerInput:       []string{"test"},
+					DifficultyInput:   []string{"3"},
+					GuessNumberInputs: []string{"25", tc.command, "50"},
+					PlayAgainInput:    []string{"2"},
+				}
+
+				gotWriter, game := initGame(mockInputSource)
+				game.PlayGame(fakeRandomNumber, stubScoreStore)
+				got := gotWriter.String()
+
+				for _, want := range tc.want {
+					assert.Contains(t, got, want)
+				}
+				assert.Contains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 2))
+			})
+		}
+	})
+
+	t.Run("spend attempts on hints", func(t *testing.T) {
+		mockInputSource := &MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"2"},
+			GuessNumberInputs: []string{":hint", ":hint", ":hint", ":hint", ":hint", "50"},
+			PlayAgainInput:    []string{"2"},
+		}
+
+		gotWriter, game := initGame(mockInputSource)
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, gameConfig["hint_even"])
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["hint_not_multiple"], 3))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["hint_multiple"], 5))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["hint_not_multiple"], 7))
+		assert.Contains(t, got, gameConfig["no_clue"])
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 5))
+	})
+
+	t.Run("give up the round", func(t *testing.T) {
+		mockInputSource := &MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"25", ":giveup"},
+			PlayAgainInput:    []string{"2"},
+		}
+
+		gotWriter, game := initGame(mockInputSource)
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["gave_up"], 50))
+		assert.NotContains(t, got, fakeScores)
+		assert.Contains(t, got, gameConfig["again"])
+		assert.Contains(t, got, gameConfig["bye"])
+	})
+
+	t.Run("quit the game", func(t *testing.T) {
+		mockInputSource := &MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{":quit"},
+		}
+
+		gotWriter, game := initGame(mockInputSource)
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.NotContains(t, got, gameConfig["again"])
+		assert.True(t, strings.HasSuffix(got,
+			gameConfig["bye"]+gameConfig["newline"],
+		))
+	})
 }
 
-type StubScoreStore struct {
-	isEmpty bool
+func TestIntegrationGameSaveAndResume(t *testing.T) {
+	t.Run("save the round on quit and resume it", func(t *testing.T) {
+		saveStore := &StubSaveStore{}
+
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"25", ":quit"},
+		})
+		game.SaveStore = saveStore
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+
+		assert.Contains(t, gotWriter.String(), gameConfig["saved"])
+		assert.NotNil(t, saveStore.game)
+		assert.NotEqual(t, "50", saveStore.game.Secret)
+
+		gotWriter, game = initGame(&MockInputSource{
+			GuessNumberInputs: []string{"50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.SaveStore = saveStore
+		game.ResumeGame(stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["resumed"], "Hard", 2))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["history_turn"], 1,
+			fmt.Sprintf(gameConfig["greater"], 25),
+		))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 2))
+		assert.Contains(t, got, fakeScores)
+		assert.Nil(t, saveStore.game)
+	})
+
+	t.Run("greet with the range of the resumed round", func(t *testing.T) {
+		saved, err := save.NewGame(engine.Resume{
+			Player:       "test",
+			Level:        "Adaptive",
+			MaxAttempts:  7,
+			RandomNumber: 150,
+			Min:          1,
+			Max:          200,
+		})
+		assert.NoError(t, err)
+
+		gotWriter, resumed := initGame(&MockInputSource{
+			GuessNumberInputs: []string{"150"},
+			PlayAgainInput:    []string{"2"},
+		})
+		resumed.SaveStore = &StubSaveStore{game: &saved}
+		resumed.ResumeGame(stubScoreStore)
+
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["greeting"], 1, 200))
+	})
+
+	t.Run("error when no game was saved", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.SaveStore = &StubSaveStore{}
+		game.ResumeGame(stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, save.NewNoSaveError().Error())
+		assert.NotContains(t, got, gameConfig["guess"])
+	})
+
+	t.Run("error when the saved round was tampered with", func(t *testing.T) {
+		saveStore := &StubSaveStore{game: &save.Game{
+			Player:      "test",
+			Level:       "Hard",
+			MaxAttempts: 3,
+			Secret:      "50",
+		}}
+
+		gotWriter, game := initGame(&MockInputSource{})
+		game.SaveStore = saveStore
+		game.ResumeGame(stubScoreStore)
+
+		assert.Contains(t, gotWriter.String(), save.NewSealError().Error())
+	})
 }
 
-func (s *StubScoreStore) Load() store.Scores {
-	if s.isEmpty {
-		return store.Scores{}
-	}
+func TestIntegrationGameRecordAndReplay(t *testing.T) {
+	t.Run("record the round and replay it", func(t *testing.T) {
+		recordStore := &StubRecordStore{}
 
-	return store.Scores{
-		{
-			Player:   "Test",
-			Level:    "Hard",
-			Attempts: 3,
-			Time:     30 * time.Second,
-		},
-	}
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"25", "75", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.RecordStore = recordStore
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+
+		assert.Contains(t, gotWriter.String(),
+			fmt.Sprintf(gameConfig["recorded"], 1),
+		)
+
+		pacer := &StubPacer{}
+		gotWriter, game = initGame(&MockInputSource{})
+		game.RecordStore = recordStore
+		game.ReplayGame(1, pacer)
+		got := gotWriter.String()
+
+		assert.Len(t, pacer.delays, 3)
+		for _, want := range []string{
+			fmt.Sprintf(gameConfig["replay_intro"], 1, "test", "Hard"),
+			fmt.Sprintf(gameConfig["greater"], 25),
+			fmt.Sprintf(gameConfig["announce"], 2, 26, 100),
+			fmt.Sprintf(gameConfig["less"], 75),
+			fmt.Sprintf(gameConfig["announce"], 1, 26, 74),
+			fmt.Sprintf(gameConfig["equal"], "0s", 3),
+			gameConfig["replay_end"],
+		} {
+			assert.Contains(t, got, want)
+		}
+	})
+
+	t.Run("stop the replay when the pacer stops", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.RecordStore = &StubRecordStore{games: record.Games{{
+			ID:           1,
+			Player:       "test",
+			Level:        "Hard",
+			MaxAttempts:  3,
+			RandomNumber: 50,
+			Steps: []record.Step{
+				{Action: record.ActionGuess, Number: 25},
+				{Action: record.ActionGuess, Number: 50},
+			},
+		}}}
+		game.ReplayGame(1, &StubPacer{stopAt: 1})
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["greater"], 25))
+		assert.NotContains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 2))
+		assert.Contains(t, got, gameConfig["replay_end"])
+	})
+
+	t.Run("error when no game has the ID", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.RecordStore = &StubRecordStore{}
+		game.ReplayGame(7, &StubPacer{})
+
+		assert.Contains(t, gotWriter.String(),
+			record.NewGameNotFoundError(7).Error(),
+		)
+	})
 }
 
-func (s *StubScoreStore) Add(store.Score) (store.Scores, error) {
-	return store.Scores{
-		{
-			Player:   "Test",
-			Level:    "Hard",
-			Attempts: 3,
-			Time:     30 * time.Second,
-		},
-	}, nil
+func TestIntegrationGamePlayTimeAttack(t *testing.T) {
+	t.Run("waste an attempt when a guess expires", func(t *testing.T) {
+		gotWriter, game := initGame(nil)
+		game.InputSource = &SlowInputSource{
+			MockInputSource: &MockInputSource{
+				PlayerInput:       []string{"test"},
+				DifficultyInput:   []string{"3"},
+				GuessNumberInputs: []string{"25", "50"},
+				PlayAgainInput:    []string{"2"},
+			},
+			GuessDelays: []time.Duration{150 * time.Millisecond},
+		}
+		game.GuessTime = 100 * time.Millisecond
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got,
+			fmt.Sprintf(gameConfig["countdown_guess"], 1)+gameConfig["guess"],
+		)
+		assert.Contains(t, got,
+			gameConfig["guess"]+gameConfig["newline"]+gameConfig["guess_expired"],
+		)
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["greater"], 25))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 3))
+	})
+
+	t.Run("lose the round when the game times out", func(t *testing.T) {
+		gotWriter, game := initGame(nil)
+		game.InputSource = &SlowInputSource{
+			MockInputSource: &MockInputSource{
+				PlayerInput:       []string{"test"},
+				DifficultyInput:   []string{"3"},
+				GuessNumberInputs: []string{"25"},
+				PlayAgainInput:    []string{"2"},
+			},
+			GuessDelays: []time.Duration{300 * time.Millisecond},
+		}
+		game.GameTime = 50 * time.Millisecond
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got,
+			fmt.Sprintf(gameConfig["countdown_game"], 1)+gameConfig["guess"],
+		)
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["time_up"], 50))
+		assert.NotContains(t, got, fmt.Sprintf(gameConfig["greater"], 25))
+		assert.Contains(t, got, gameConfig["bye"])
+	})
 }
 
-type MockInputSource struct {
-	PlayerInput []string
-	playerIndex int
+func TestIntegrationGamePlayLateGuess(t *testing.T) {
+	t.Run("answer the play-again prompt with the first line after a time out",
+		func(t *testing.T) {
+			reader, writer := io.Pipe()
+			gotWriter, game := initGame(nil)
+			game.InputSource = &cli.CliInput{Source: reader}
+			game.GameTime = 50 * time.Millisecond
 
-	DifficultyInput []string
-	difficultyIndex int
+			go func() {
+				fmt.Fprintln(writer, "test")
+				fmt.Fprintln(writer, "3")
+				time.Sleep(150 * time.Millisecond)
+				fmt.Fprintln(writer, "1")
+				fmt.Fprintln(writer, "test")
+				fmt.Fprintln(writer, "3")
+				time.Sleep(150 * time.Millisecond)
+				fmt.Fprintln(writer, "2")
+			}()
+			game.PlayGame(fakeRandomNumber, stubScoreStore)
+			got := gotWriter.String()
 
-	GuessNumberInputs []string
-	guessNumberIndex  int
+			assert.Contains(t, got, fmt.Sprintf(gameConfig["time_up"], 50))
+			assert.Equal(t, 2, strings.Count(got, gameConfig["player"]))
+			assert.Equal(t, 2, strings.Count(got, gameConfig["again"]))
+			assert.Contains(t, got, gameConfig["bye"])
+		})
+}
 
-	PlayAgainInput []string
-	playAgainIndex int
+func TestIntegrationGameScores(t *testing.T) {
+	t.Run("score the won round with the points of the formula", func(t *testing.T) {
+		scoresStore := &store.ScoresStore{
+			FilePath: filepath.Join(t.TempDir(), "scores.json"),
+		}
+		_, scored := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"40", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		scored.Formula = scoring.Formula{
+			Base:          map[string]int{"hard": 500},
+			UnusedAttempt: 50,
+		}
+		scored.PlayGame(fakeRandomNumber, scoresStore)
+
+		got := scoresStore.Load()
+		assert.Len(t, got, 1)
+		assert.Equal(t, "test", got[0].Player)
+		assert.Equal(t, "Hard", got[0].Level)
+		assert.Equal(t, 2, got[0].Attempts)
+		assert.Equal(t, 3, got[0].MaxAttempts)
+		assert.Equal(t, 550, got[0].Points)
+	})
+
+	t.Run("leave the lost round unscored", func(t *testing.T) {
+		scoresStore := &store.ScoresStore{
+			FilePath: filepath.Join(t.TempDir(), "scores.json"),
+		}
+		_, scored := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"10", "20", "30"},
+			PlayAgainInput:    []string{"2"},
+		})
+		scored.PlayGame(fakeRandomNumber, scoresStore)
+
+		assert.Equal(t, store.Scores{}, scoresStore.Load())
+	})
 }
 
-func (m *MockInputSource) getNextInput(
-	inputs []string,
-	index *int,
-) (string, error) {
-	if *index < len(inputs) {
-		value := inputs[*index]
-		(*index)++
-		return value, nil
-	}
-	return "", fmt.Errorf("no more inputs")
+func TestIntegrationGameRatings(t *testing.T) {
+	t.Run("rate the player and show the stats", func(t *testing.T) {
+		ratingStore := &StubRatingStore{ratings: rating.Ratings{}}
+
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.RatingStore = ratingStore
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+
+		assert.Contains(t, gotWriter.String(),
+			fmt.Sprintf(gameConfig["rated"], 1224, 24),
+		)
+
+		gotWriter, game = initGame(&MockInputSource{})
+		game.RatingStore = ratingStore
+		game.ShowStats("test")
+		got := gotWriter.String()
+
+		assert.Contains(t, got, gameConfig["stats_ratings"])
+		assert.Contains(t, got, ratingStore.ratings.Standings().String())
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["stats_history"], "test"))
+		assert.Contains(t, got, ratingStore.ratings["test"].String())
+	})
+
+	t.Run("rate the player by profile", func(t *testing.T) {
+		ratingStore := &StubRatingStore{ratings: rating.Ratings{}}
+		profileStore := &profile.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "profiles.json"),
+		}
+		profiles := profile.Profiles{}
+		profiles.Create("Ann", time.Now())
+		assert.NoError(t, profileStore.Save(profiles))
+
+		_, rated := initGame(&MockInputSource{
+			PlayerInput:       []string{"ann"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		rated.RatingStore = ratingStore
+		rated.ProfileStore = profileStore
+		rated.PlayGame(fakeRandomNumber, stubScoreStore)
+
+		assert.Len(t, ratingStore.ratings["profile:1"], 1)
+		assert.NotContains(t, ratingStore.ratings, "Ann")
+
+		gotWriter, rated := initGame(&MockInputSource{})
+		rated.RatingStore = ratingStore
+		rated.ProfileStore = profileStore
+		rated.ShowStats("ANN")
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["stats_history"], "Ann"))
+		assert.Contains(t, got, ratingStore.ratings["profile:1"].String())
+	})
+
+	t.Run("show no ratings yet", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.ShowStats("")
+		got := gotWriter.String()
+
+		assert.Contains(t, got, rating.NoRatings)
+		assert.NotContains(t, got, "%!")
+	})
 }
 
-func (m *MockInputSource) NextPlayerInput() (string, error) {
-	return m.getNextInput(m.PlayerInput, &m.playerIndex)
+func TestIntegrationGameAchievements(t *testing.T) {
+	t.Run("announce the badges earned and list them", func(t *testing.T) {
+		achievementStore := &StubAchievementStore{players: achievement.Players{}}
+
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.AchievementStore = achievementStore
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		for _, id := range []string{
+			achievement.FirstWin,
+			achievement.FirstGuess,
+			achievement.BinarySearch,
+		} {
+			badge, _ := achievement.Find(id)
+			assert.Contains(t, got,
+				fmt.Sprintf(gameConfig["badge"], badge.Name, badge.Description),
+			)
+		}
+
+		gotWriter, game = initGame(&MockInputSource{})
+		game.AchievementStore = achievementStore
+		game.ShowBadges("test")
+		got = gotWriter.String()
+
+		assert.Contains(t, got, gameConfig["badges_players"])
+		assert.Contains(t, got, achievementStore.players.String())
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["badges_player"], "test"))
+		assert.Contains(t, got, achievementStore.players["test"].String())
+	})
+
+	t.Run("show no badges yet", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.ShowBadges("")
+		got := gotWriter.String()
+
+		assert.Contains(t, got, achievement.NoBadges)
+		assert.NotContains(t, got, "%!")
+	})
 }
 
-func (m *MockInputSource) NextDifficultyInput() (string, error) {
-	return m.getNextInput(m.DifficultyInput, &m.difficultyIndex)
+func TestIntegrationGameAnalysis(t *testing.T) {
+	t.Run("report and store the efficiency of the round", func(t *testing.T) {
+		scoresStore := &store.ScoresStore{
+			FilePath: filepath.Join(t.TempDir(), "scores.json"),
+		}
+
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"1"},
+			GuessNumberInputs: []string{"40", "30", "40", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.PlayGame(fakeRandomNumber, scoresStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, gameConfig["analysis"])
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["analysis_outside"], 2, 30, 41, 100))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["analysis_repeated"], 3, 40))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["analysis_optimal"], 4, 1))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["analysis_efficiency"], 25))
+		assert.Equal(t, 25, scoresStore.Load()[0].Efficiency)
+	})
 }
 
-func (m *MockInputSource) NextGuessNumberInput() (string, error) {
-	return m.getNextInput(m.GuessNumberInputs, &m.guessNumberIndex)
+func TestIntegrationGameHints(t *testing.T) {
+	t.Run("give the hints of the strategy of the level", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"1"},
+			GuessNumberInputs: []string{"40", "45", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.Rounds.Hints = hint.Strategies{"easy": hint.HotCold{}}
+		game.PlayGame(fakeRandomNumber, &StubScoreStore{})
+		got := gotWriter.String()
+
+		assert.Contains(t, got, gameConfig["hot_first"])
+		assert.Contains(t, got, gameConfig["warmer"])
+		assert.NotContains(t, got, gameConfig["very_far"])
+	})
+
+	t.Run("give no hint", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"40", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.Rounds.Hints = hint.Strategies{"hard": hint.None{}}
+		game.PlayGame(fakeRandomNumber, &StubScoreStore{})
+		got := gotWriter.String()
+
+		want := fmt.Sprintf(gameConfig["greater"], 40) +
+			gameConfig["newline"] +
+			gameConfig["spacer"] +
+			gameConfig["guess"]
+		assert.Contains(t, got, want)
+		assert.NotContains(t, got, gameConfig["very_far"])
+	})
 }
 
-func (m *MockInputSource) NextPlayAgainInput() (string, error) {
-	return m.getNextInput(m.PlayAgainInput, &m.playAgainIndex)
+func TestIntegrationGameLies(t *testing.T) {
+	t.Run("warn of the lies and reveal them at the end", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"40", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.Rounds.Liar = oracle.Liar{Rate: 1, MaxLies: map[string]int{"hard": 1}}
+		game.PlayGame(fakeRandomNumber, &StubScoreStore{})
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["lies"], 1))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["less"], 40))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["lies_revealed"], 1, 1))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["lie"], 1, 40, "greater"))
+	})
+
+	t.Run("simulate the solver", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.ShowSimulation(1)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["simulation"], 1, 100))
+		assert.Contains(t, got, "LIES")
+		assert.NotContains(t, got, "%!")
+	})
+}
+
+func TestIntegrationGamePlayCode(t *testing.T) {
+	t.Run("crack the code with bulls and cows and store its variant", func(t *testing.T) {
+		scoresStore := &store.ScoresStore{
+			FilePath: filepath.Join(t.TempDir(), "scores.json"),
+		}
+
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"1"},
+			GuessNumberInputs: []string{"4321", "12", "1243", "1234"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.Rounds.Variant = "code"
+		game.PlayGame(1234, scoresStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["greeting"], 1000, 9999))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["code"], 4, 10))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["mismatch"], 4321, 0, 4))
+		assert.Contains(t, got, parser.NewNumberRangeError(1000, 9999).Error())
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["mismatch"], 1243, 2, 2))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 3))
+		assert.NotContains(t, got, gameConfig["very_far"])
+		assert.NotContains(t, got, gameConfig["analysis"])
+
+		scores := scoresStore.Load()
+		assert.Len(t, scores, 1)
+		assert.Equal(t, "code", scores[0].Variant)
+	})
+
+	t.Run("leave the won code unrated", func(t *testing.T) {
+		ratingStore := &StubRatingStore{ratings: rating.Ratings{}}
+		gotWriter, coded := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"1"},
+			GuessNumberInputs: []string{"1234"},
+			PlayAgainInput:    []string{"2"},
+		})
+		coded.Rounds.Variant = "code"
+		coded.RatingStore = ratingStore
+		coded.PlayGame(1234, stubScoreStore)
+
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["equal"], "0s", 1))
+		assert.NotContains(t, gotWriter.String(), strings.Split(gameConfig["rated"], "%")[0])
+		assert.Equal(t, rating.Ratings{}, ratingStore.ratings)
+	})
+
+	t.Run("error when the codes can't have the digits", func(t *testing.T) {
+		for _, digits := range []int{-1, 10, 19} {
+			gotWriter, coded := initGame(&MockInputSource{
+				PlayerInput:     []string{"test"},
+				DifficultyInput: []string{"1"},
+			})
+			coded.Rounds.Variant = "code"
+			coded.Rounds.CodeDigits = digits
+			coded.PlayGame(fakeRandomNumber, stubScoreStore)
+
+			assert.Contains(t, gotWriter.String(), game.NewCodeDigitsError().Error())
+		}
+	})
+}
+
+func TestIntegrationGamePlayMulti(t *testing.T) {
+	t.Run("hit a hidden number and reveal them all on giving up", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"1"},
+			GuessNumberInputs: []string{"42", ":giveup"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.Rounds.Variant = "multi"
+		game.Rounds.Secrets = 2
+		game.PlayGame(42, &StubScoreStore{})
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["multi"], 2, 1, 100, 10))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["hit"], 42))
+		assert.Contains(t, got, "Hidden numbers left: 1, ")
+		assert.Contains(t, got, "The hidden numbers were 42, ")
+		assert.NotContains(t, got, gameConfig["very_far"])
+	})
+
+	t.Run("error when the hidden numbers can't be drawn", func(t *testing.T) {
+		gotWriter, multi := initGame(&MockInputSource{
+			PlayerInput:     []string{"test"},
+			DifficultyInput: []string{"1"},
+		})
+		multi.Rounds.Variant = "multi"
+		multi.Rounds.Secrets = 9
+		multi.PlayGame(42, &StubScoreStore{})
+
+		want := game.NewSecretsError().Error()
+		assert.Contains(t, gotWriter.String(), want)
+	})
+}
+
+func TestIntegrationGamePlayDrift(t *testing.T) {
+	t.Run("chase the number as it moves and reveal where it stands", func(t *testing.T) {
+		gotWriter, drift := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"1"},
+			GuessNumberInputs: []string{"10", "90", ":giveup"},
+			PlayAgainInput:    []string{"2"},
+		})
+		drift.Rounds.Variant = "drift"
+		drift.Rounds.Seed = 7
+		drift.PlayGame(50, &StubScoreStore{})
+		got := gotWriter.String()
+
+		gameState := game.GameState{
+			Level:        "Easy",
+			MaxAttempts:  10,
+			RandomNumber: 50,
+			Variant:      game.DriftVariant,
+			Seed:         7,
+		}
+		for _, guess := range []int{10, 90} {
+			assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: guess}))
+		}
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["drift"], game.DriftStep))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["greater"], 10))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["less"], 90))
+		assert.Contains(t, got,
+			fmt.Sprintf(gameConfig["gave_up"], gameState.Position()),
+		)
+	})
+}
+
+func TestIntegrationGamePlayAdaptive(t *testing.T) {
+	t.Run("tune the round to the recent scores", func(t *testing.T) {
+		scoresStore := &store.ScoresStore{
+			FilePath: filepath.Join(t.TempDir(), "scores.json"),
+		}
+		for _, attempts := range []int{2, 4, 3} {
+			_, err := scoresStore.Add(store.Score{
+				Player:      "test",
+				Level:       "Adaptive",
+				Attempts:    attempts,
+				MaxAttempts: 7,
+				Min:         1,
+				Max:         100,
+			})
+			assert.NoError(t, err)
+		}
+
+		gotWriter, game := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"4"},
+			GuessNumberInputs: []string{"150", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		game.PlayGame(fakeRandomNumber, scoresStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["level"], "Adaptive"))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["adaptive"], 1, 200, 7))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["less"], 150))
+		assert.NotContains(t, got, parser.NewNumberRangeError(1, 100).Error())
+
+		scores := scoresStore.Load()
+		last := scores[len(scores)-1]
+		assert.Equal(t, 7, last.MaxAttempts)
+		assert.Equal(t, 1, last.Min)
+		assert.Equal(t, 200, last.Max)
+	})
+}
+
+func TestIntegrationGamePlayAccessible(t *testing.T) {
+	t.Run("announce progress and read scores as sentences", func(t *testing.T) {
+		mockInputSource := &MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"25", "75", "50"},
+			PlayAgainInput:    []string{"2"},
+		}
+		accessibleConfig := config.MergeConfig(gameConfig,
+			config.LoadConfig("yaml", "../../configs/accessible.yaml"),
+		)
+
+		gotWriter := &bytes.Buffer{}
+		game := service.Game{
+			InputSource: mockInputSource,
+			GameConfig:  accessibleConfig,
+			Writer:      gotWriter,
+			Accessible:  true,
+		}
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["announce"], 2, 26, 100))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["announce"], 1, 26, 74))
+		assert.Contains(t, got, stubScoreStore.Load().Sentences())
+		assert.NotContains(t, got, fakeScores)
+		assert.NotContains(t, got, "\n\n")
+	})
+}
+
+func TestIntegrationGamePlayScreen(t *testing.T) {
+	t.Run("draw full-screen frames", func(t *testing.T) {
+		mockInputSource := &MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"48", "50"},
+			PlayAgainInput:    []string{"2"},
+		}
+
+		gotWriter, game := initGame(mockInputSource)
+		game.Screen = &tui.Screen{
+			Writer:     gotWriter,
+			GameConfig: gameConfig,
+			Width:      tui.DefaultWidth,
+		}
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, "\x1b[H\x1b[2J")
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tui_title"], "test", "Hard"))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tui_turn"], 1, 48, "↑"))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tui_turn"], 2, 50, "✓"))
+		assert.Contains(t, got, gameConfig["very_close_2"])
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["equal"], "0s", 2))
+		assert.Contains(t, got, fakeScores)
+		assert.Contains(t, got, gameConfig["bye"])
+	})
+}
+
+func TestIntegrationGamePlayTheme(t *testing.T) {
+	t.Run("style results, hints and errors", func(t *testing.T) {
+		mockInputSource := &MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"incorrect", "48", "50"},
+			PlayAgainInput:    []string{"2"},
+		}
+
+		gotWriter, game := initGame(mockInputSource)
+		game.Theme = cli.Theme{
+			"greater":      "36",
+			"equal":        "32",
+			"error":        "31",
+			"very_close_2": "33",
+		}
+		game.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, game.Theme.Paint("error", parser.ParseNumberMessage))
+		assert.Contains(t, got, game.Theme.Paint("greater",
+			fmt.Sprintf(gameConfig["greater"], 48),
+		))
+		assert.Contains(t, got, game.Theme.Paint("very_close_2",
+			gameConfig["very_close_2"],
+		))
+		assert.Contains(t, got, game.Theme.Paint("equal",
+			fmt.Sprintf(gameConfig["equal"], "0s", 2),
+		))
+	})
+}
+
+func TestIntegrationGameTournament(t *testing.T) {
+	newTournament := func(t *testing.T) *tournament.FileStore {
+		t.Helper()
+
+		tournamentStore := &tournament.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "tournament.json"),
+		}
+		_, game := initGame(&MockInputSource{})
+		game.TournamentStore = tournamentStore
+		game.NewTournament(tournament.Elimination, "3", []string{"ann", "bob"})
+
+		return tournamentStore
+	}
+
+	// wrong returns a guess other than the number.
+	wrong := func(number int) string {
+		return strconv.Itoa(number%100 + 1)
+	}
+
+	t.Run("play the matches on the same number and crown the champion", func(t *testing.T) {
+		tournamentStore := newTournament(t)
+		saved, err := tournamentStore.Load()
+		assert.NoError(t, err)
+		number := saved.Matches[0].Number()
+
+		gotWriter, game := initGame(&MockInputSource{
+			GuessNumberInputs: []string{
+				strconv.Itoa(number),
+				wrong(number), wrong(number), wrong(number),
+			},
+		})
+		game.TournamentStore = tournamentStore
+		game.PlayTournament(&StubScoreStore{})
+		got := gotWriter.String()
+
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tournament_match"], 1, "ann", "bob"))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tournament_turn"], "bob", "Hard", 3))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tournament_winner"], "ann"))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tournament_champion"], "ann"))
+
+		played, err := tournamentStore.Load()
+		assert.NoError(t, err)
+		assert.True(t, played.Over())
+	})
+
+	t.Run("clear the terminal before the away turn", func(t *testing.T) {
+		tournamentStore := newTournament(t)
+		saved, err := tournamentStore.Load()
+		assert.NoError(t, err)
+		number := saved.Matches[0].Number()
+
+		gotWriter, game := initGame(&MockInputSource{
+			GuessNumberInputs: []string{
+				strconv.Itoa(number),
+				wrong(number), wrong(number), wrong(number),
+			},
+		})
+		game.TournamentStore = tournamentStore
+		game.Terminal = true
+		game.PlayTournament(&StubScoreStore{})
+		got := gotWriter.String()
+
+		assert.Equal(t, 1, strings.Count(got, cli.ClearScreen))
+		assert.Contains(t, got, cli.ClearScreen+
+			fmt.Sprintf(gameConfig["tournament_match"], 1, "ann", "bob")+
+			gameConfig["newline"]+
+			fmt.Sprintf(gameConfig["tournament_turn"], "bob", "Hard", 3),
+		)
+	})
+
+	t.Run("lose the round left by a player who quit", func(t *testing.T) {
+		tournamentStore := newTournament(t)
+		saved, err := tournamentStore.Load()
+		assert.NoError(t, err)
+		number := saved.Matches[0].Number()
+
+		gotWriter, game := initGame(&MockInputSource{
+			GuessNumberInputs: []string{wrong(number), ":quit"},
+		})
+		game.TournamentStore = tournamentStore
+		game.SaveStore = &StubSaveStore{}
+		game.PlayTournament(&StubScoreStore{})
+		got := gotWriter.String()
+
+		assert.NotContains(t, got, gameConfig["saved"])
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["tournament_left"], "ann"))
+		assert.NotContains(t, got, "champion")
+
+		played, err := tournamentStore.Load()
+		assert.NoError(t, err)
+		assert.False(t, played.Matches[0].HomeResult.Won)
+		assert.Equal(t, 3, played.Matches[0].HomeResult.Attempts)
+		next, _ := played.Matches[0].Next()
+		assert.Equal(t, "bob", next)
+	})
+
+	t.Run("error when no tournament was started", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.TournamentStore = &tournament.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "tournament.json"),
+		}
+		game.PlayTournament(&StubScoreStore{})
+
+		want := tournament.NewNoTournamentError().Error()
+		assert.Contains(t, gotWriter.String(), want)
+	})
+
+	t.Run("error when the level has no fixed attempts", func(t *testing.T) {
+		gotWriter, game := initGame(&MockInputSource{})
+		game.NewTournament(tournament.RoundRobin, "4", []string{"ann", "bob"})
+
+		want := tournament.NewLevelError().Error()
+		assert.Contains(t, gotWriter.String(), want)
+	})
+
+	t.Run("error when the names of the players are invalid", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			players     []string
+			want        error
+		}{
+			{
+				description: "escape sequence",
+				players:     []string{"ann", "\x1b[31mbob"},
+				want:        parser.NewParsePlayerControlError(),
+			},
+			{
+				description: "name too wide",
+				players:     []string{"ann", strings.Repeat("b", 21)},
+				want:        parser.NewParsePlayerError(1, 20),
+			},
+			{
+				description: "same name cased and spaced differently",
+				players:     []string{"bob", "ann", " Bob "},
+				want:        tournament.NewPlayersError(),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				tournamentStore := &tournament.FileStore{
+					FilePath: filepath.Join(t.TempDir(), "tournament.json"),
+				}
+				gotWriter, registrar := initGame(&MockInputSource{})
+				registrar.TournamentStore = tournamentStore
+				registrar.NewTournament(tournament.RoundRobin, "3", tc.players)
+
+				assert.Contains(t, gotWriter.String(), tc.want.Error())
+				_, err := tournamentStore.Load()
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("register the players by the display names of their profiles", func(t *testing.T) {
+		profileStore := &profile.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "profiles.json"),
+		}
+		profiles := profile.Profiles{}
+		profiles.Create("Ann", time.Now())
+		assert.NoError(t, profileStore.Save(profiles))
+		tournamentStore := &tournament.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "tournament.json"),
+		}
+
+		_, registrar := initGame(&MockInputSource{})
+		registrar.TournamentStore = tournamentStore
+		registrar.ProfileStore = profileStore
+		registrar.NewTournament(tournament.RoundRobin, "3", []string{" ANN", "bob"})
+
+		registered, err := tournamentStore.Load()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Ann", "bob"}, registered.Players)
+	})
+}
+
+func initGame(mockInputSource *MockInputSource) (*bytes.Buffer, service.Game) {
+	gotWriter := &bytes.Buffer{}
+	game := service.Game{
+		InputSource: mockInputSource,
+		GameConfig:  gameConfig,
+		Writer:      gotWriter,
+	}
+	return gotWriter, game
+}
+
+type StubScoreStore struct {
+	isEmpty bool
+}
+
+func (s *StubScoreStore) Load() store.Scores {
+	if s.isEmpty {
+		return store.Scores{}
+	}
+
+	return store.Scores{
+		{
+			Player:   "Test",
+			Level:    "Hard",
+			Attempts: 3,
+			Time:     30 * time.Second,
+		},
+	}
+}
+
+func (s *StubScoreStore) Add(store.Score) (store.Scores, error) {
+	return store.Scores{
+		{
+			Player:   "Test",
+			Level:    "Hard",
+			Attempts: 3,
+			Time:     30 * time.Second,
+		},
+	}, nil
+}
+
+type StubSaveStore struct {
+	game *save.Game
+}
+
+func (s *StubSaveStore) Save(game save.Game) error {
+	s.game = &game
+	return nil
+}
+
+func (s *StubSaveStore) Load() (save.Game, error) {
+	if s.game == nil {
+		return save.Game{}, save.NewNoSaveError()
+	}
+	return *s.game, nil
+}
+
+func (s *StubSaveStore) Delete() error {
+	s.game = nil
+	return nil
+}
+
+type StubRecordStore struct {
+	games record.Games
+}
+
+func (s *StubRecordStore) Load() record.Games {
+	return s.games
+}
+
+func (s *StubRecordStore) Add(game record.Game) (record.Game, error) {
+	game.ID = len(s.games) + 1
+	s.games = append(s.games, game)
+	return game, nil
+}
+
+type StubAchievementStore struct {
+	players achievement.Players
+}
+
+func (s *StubAchievementStore) Load() achievement.Players {
+	return s.players
+}
+
+func (s *StubAchievementStore) Save(
+	player string,
+	progress achievement.Progress,
+) (achievement.Players, error) {
+	s.players[player] = progress
+	return s.players, nil
+}
+
+type StubRatingStore struct {
+	ratings rating.Ratings
+}
+
+func (s *StubRatingStore) Load() rating.Ratings {
+	return s.ratings
+}
+
+func (s *StubRatingStore) Add(
+	player string,
+	entry rating.Entry,
+) (rating.Ratings, error) {
+	s.ratings[player] = append(s.ratings[player], entry)
+	return s.ratings, nil
+}
+
+type StubPacer struct {
+	delays []time.Duration
+	stopAt int
+}
+
+func (s *StubPacer) Wait(delay time.Duration) error {
+	if s.stopAt > 0 && len(s.delays) == s.stopAt {
+		return io.EOF
+	}
+	s.delays = append(s.delays, delay)
+	return nil
+}
+
+// SlowInputSource delays the guesses, as a player thinking.
+type SlowInputSource struct {
+	*MockInputSource
+	GuessDelays []time.Duration
+}
+
+func (s *SlowInputSource) NextGuessNumberInput() (string, error) {
+	if s.guessNumberIndex < len(s.GuessDelays) {
+		time.Sleep(s.GuessDelays[s.guessNumberIndex])
+	}
+	return s.MockInputSource.NextGuessNumberInput()
+}
+
+type MockInputSource struct {
+	PlayerInput []string
+	playerIndex int
+
+	DifficultyInput []string
+	difficultyIndex int
+
+	GuessNumberInputs []string
+	guessNumberIndex  int
+
+	PlayAgainInput []string
+	playAgainIndex int
+}
+
+func (m *MockInputSource) getNextInput(
+	inputs []string,
+	index *int,
+) (string, error) {
+	if *index < len(inputs) {
+		value := inputs[*index]
+		(*index)++
+		return value, nil
+	}
+	return "", fmt.Errorf("no more inputs")
+}
+
+func (m *MockInputSource) NextPlayerInput() (string, error) {
+	return m.getNextInput(m.PlayerInput, &m.playerIndex)
+}
+
+func (m *MockInputSource) NextDifficultyInput() (string, error) {
+	return m.getNextInput(m.DifficultyInput, &m.difficultyIndex)
+}
+
+func (m *MockInputSource) NextGuessNumberInput() (string, error) {
+	return m.getNextInput(m.GuessNumberInputs, &m.guessNumberIndex)
+}
+
+func (m *MockInputSource) NextPlayAgainInput() (string, error) {
+	return m.getNextInput(m.PlayAgainInput, &m.playAgainIndex)
+}
+
+func TestIntegrationGameSeason(t *testing.T) {
+	newStores := func(t *testing.T) (*store.ScoresStore, *season.FileStore) {
+		t.Helper()
+
+		dir := t.TempDir()
+		scoresStore := &store.ScoresStore{FilePath: filepath.Join(dir, "scores.json")}
+		for _, score := range []store.Score{
+			{Player: "ann", Level: "Easy", Attempts: 4},
+			{Player: "bob", Level: "Hard", Attempts: 5},
+		} {
+			_, err := scoresStore.Add(score)
+			assert.NoError(t, err)
+		}
+
+		return scoresStore, &season.FileStore{FilePath: filepath.Join(dir, "seasons.json")}
+	}
+
+	t.Run("start a new season crowning the best ranked player", func(t *testing.T) {
+		scoresStore, seasonStore := newStores(t)
+		gotWriter, seasonal := initGame(&MockInputSource{})
+		seasonal.SeasonStore = seasonStore
+
+		seasonal.RollSeason(scoresStore, true)
+
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["season_over"], 1, "bob"))
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["season_new"], 2))
+		assert.Equal(t, 2, scoresStore.Season)
+		assert.Empty(t, scoresStore.Load())
+		assert.Equal(t, map[string][]int{"bob": {1}}, seasonStore.Load(time.Now()).Champions)
+
+		gotWriter.Reset()
+		seasonal.ShowScores(scoresStore, 1)
+		got := gotWriter.String()
+		assert.Contains(t, got, "Season 1 leaderboard, from ")
+		assert.Contains(t, got, "ann")
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["season_champion"], "bob"))
+
+		gotWriter.Reset()
+		seasonal.ShowScores(scoresStore, 0)
+		assert.Contains(t, gotWriter.String(), "Season 2 leaderboard, since ")
+		assert.NotContains(t, gotWriter.String(), "ann")
+
+		gotWriter.Reset()
+		seasonal.ShowSeasons()
+		assert.Contains(t, gotWriter.String(), gameConfig["season_champions"])
+		assert.Contains(t, gotWriter.String(), "| bob    |      1 |       1 |")
+	})
+
+	t.Run("crown the champion by profile", func(t *testing.T) {
+		scoresStore, seasonStore := newStores(t)
+		_, err := scoresStore.Add(store.Score{Player: "Carol", Level: "Hard", Attempts: 1, ProfileID: 3})
+		assert.NoError(t, err)
+		_, seasonal := initGame(&MockInputSource{})
+		seasonal.SeasonStore = seasonStore
+
+		seasonal.RollSeason(scoresStore, true)
+
+		seasons := seasonStore.Load(time.Now())
+		assert.Equal(t, 3, seasons.Archive[0].ChampionID)
+		assert.Equal(t, map[string][]int{"profile:3": {1}}, seasons.Champions)
+	})
+
+	t.Run("crown the best points of the number variant", func(t *testing.T) {
+		scoresStore, seasonStore := newStores(t)
+		for _, score := range []store.Score{
+			{Player: "carol", Level: "Hard", Variant: "code", Points: 900},
+			{Player: "dave", Level: "Easy", Points: 300},
+		} {
+			_, err := scoresStore.Add(score)
+			assert.NoError(t, err)
+		}
+		gotWriter, seasonal := initGame(&MockInputSource{})
+		seasonal.SeasonStore = seasonStore
+		seasonal.Ranking = store.RankingPoints
+
+		seasonal.RollSeason(scoresStore, true)
+
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["season_over"], 1, "dave"))
+	})
+
+	t.Run("roll over only once the season length passed", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			started     time.Time
+			want        int
+		}{
+			{
+				description: "season in progress",
+				started:     time.Now().Add(-time.Hour),
+				want:        1,
+			},
+			{
+				description: "season over",
+				started:     time.Now().Add(-25 * time.Hour),
+				want:        2,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				scoresStore, seasonStore := newStores(t)
+				assert.NoError(t, seasonStore.Save(season.New(tc.started)))
+				_, seasonal := initGame(&MockInputSource{})
+				seasonal.SeasonStore = seasonStore
+				seasonal.SeasonLength = 24 * time.Hour
+
+				seasonal.RollSeason(scoresStore, false)
+
+				assert.Equal(t, tc.want, scoresStore.Season)
+				assert.Equal(t, tc.want, seasonStore.Load(time.Now()).Current.Number)
+			})
+		}
+	})
+
+	t.Run("error when the season hasn't been played", func(t *testing.T) {
+		scoresStore, seasonStore := newStores(t)
+		gotWriter, seasonal := initGame(&MockInputSource{})
+		seasonal.SeasonStore = seasonStore
+
+		seasonal.ShowScores(scoresStore, 5)
+
+		assert.Contains(t, gotWriter.String(), season.NewNoSeasonError(5).Error())
+	})
+}
+
+func TestIntegrationGameProfile(t *testing.T) {
+	newStores := func(t *testing.T) (*store.ScoresStore, *profile.FileStore) {
+		t.Helper()
+
+		dir := t.TempDir()
+		return &store.ScoresStore{FilePath: filepath.Join(dir, "scores.json")},
+			&profile.FileStore{FilePath: filepath.Join(dir, "profiles.json")}
+	}
+
+	t.Run("create the profile of a valid new name and score its rounds", func(t *testing.T) {
+		scoresStore, profileStore := newStores(t)
+		gotWriter, profiled := initGame(&MockInputSource{
+			PlayerInput:       []string{"\x1b[31mBob", "  Bob "},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		profiled.ProfileStore = profileStore
+
+		profiled.PlayGame(fakeRandomNumber, scoresStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, parser.NewParsePlayerControlError().Error())
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["profile_created"], "Bob"))
+		assert.NotContains(t, got, gameConfig["player"])
+		bob, ok := profileStore.Load().Find("bob")
+		assert.True(t, ok)
+		assert.Equal(t, 1, bob.ID)
+		scores := scoresStore.Load()
+		assert.Len(t, scores, 1)
+		assert.Equal(t, "Bob", scores[0].Player)
+		assert.Equal(t, 1, scores[0].ProfileID)
+	})
+
+	t.Run("pick an existing profile on its preferred level", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			input       string
+		}{
+			{description: "by ID", input: "2"},
+			{description: "by name", input: " ANN"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				scoresStore, profileStore := newStores(t)
+				profiles := profile.Profiles{}
+				profiles.Create("Bob", time.Now())
+				profiles.Create("Ann", time.Now())
+				assert.NoError(t, profileStore.Save(profiles))
+				_, setter := initGame(&MockInputSource{})
+				setter.ProfileStore = profileStore
+				setter.SetProfile("ann", "1", "", "")
+
+				gotWriter, profiled := initGame(&MockInputSource{
+					PlayerInput:       []string{tc.input},
+					GuessNumberInputs: []string{"50"},
+					PlayAgainInput:    []string{"2"},
+				})
+				profiled.ProfileStore = profileStore
+
+				profiled.PlayGame(fakeRandomNumber, scoresStore)
+				got := gotWriter.String()
+
+				assert.Contains(t, got, fmt.Sprintf(gameConfig["profile_welcome"], "Ann"))
+				assert.Contains(t, got, fmt.Sprintf(gameConfig["profile_level"], "Easy"))
+				assert.NotContains(t, got, gameConfig["difficulty"])
+				assert.Equal(t, 2, scoresStore.Load()[0].ProfileID)
+			})
+		}
+	})
+
+	t.Run("error when the preferences can't be set", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			name        string
+			locale      string
+			theme       string
+			want        error
+		}{
+			{
+				description: "unknown profile",
+				name:        "carol",
+				want:        profile.NewNoProfileError("carol"),
+			},
+			{
+				description: "unknown locale",
+				name:        "bob",
+				locale:      "xx",
+				want:        profile.NewLocaleError("xx"),
+			},
+			{
+				description: "unknown theme",
+				name:        "bob",
+				theme:       "neon",
+				want:        profile.NewThemeError("neon"),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				_, profileStore := newStores(t)
+				profiles := profile.Profiles{}
+				profiles.Create("Bob", time.Now())
+				assert.NoError(t, profileStore.Save(profiles))
+				gotWriter, setter := initGame(&MockInputSource{})
+				setter.ProfileStore = profileStore
+				setter.Locales = map[string]map[string]string{"fr": {}}
+				setter.Themes = map[string]string{"mono.greater": "1"}
+
+				setter.SetProfile(tc.name, "", tc.locale, tc.theme)
+
+				assert.Contains(t, gotWriter.String(), tc.want.Error())
+				bob, _ := profileStore.Load().Find("bob")
+				assert.Empty(t, bob.Locale)
+				assert.Empty(t, bob.Theme)
+			})
+		}
+	})
+
+	t.Run("set a theme of the themes config", func(t *testing.T) {
+		_, profileStore := newStores(t)
+		profiles := profile.Profiles{}
+		profiles.Create("Bob", time.Now())
+		assert.NoError(t, profileStore.Save(profiles))
+		gotWriter, setter := initGame(&MockInputSource{})
+		setter.ProfileStore = profileStore
+		setter.Themes = map[string]string{"mono.greater": "1"}
+
+		setter.SetProfile("bob", "", "", "mono")
+
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["profile_updated"], "bob"))
+		bob, _ := profileStore.Load().Find("bob")
+		assert.Equal(t, "mono", bob.Theme)
+	})
+
+	t.Run("play with the messages of the preferred locale", func(t *testing.T) {
+		scoresStore, profileStore := newStores(t)
+		profiles := profile.Profiles{}
+		profiles.Create("Ann", time.Now())
+		assert.NoError(t, profileStore.Save(profiles))
+		locales := config.LoadLocales("yaml", "../../configs/locales")
+		_, setter := initGame(&MockInputSource{})
+		setter.ProfileStore = profileStore
+		setter.Locales = locales
+		setter.SetProfile("ann", "", "fr", "")
+
+		gotWriter, localized := initGame(&MockInputSource{
+			PlayerInput:       []string{"ann"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		localized.ProfileStore = profileStore
+		localized.Locales = locales
+		localized.PlayGame(fakeRandomNumber, scoresStore)
+		got := gotWriter.String()
+
+		assert.Equal(t, "fr", profileStore.Load()[0].Locale)
+		assert.Contains(t, got, locales["fr"]["difficulty"])
+		assert.Contains(t, got, locales["fr"]["bye"])
+		assert.NotContains(t, got, gameConfig["bye"])
+	})
+
+	t.Run("play the rounds after a resumed round as its player", func(t *testing.T) {
+		scoresStore, profileStore := newStores(t)
+		profiles := profile.Profiles{}
+		profiles.Create("Ann", time.Now())
+		assert.NoError(t, profileStore.Save(profiles))
+		saveStore := &StubSaveStore{}
+
+		_, quitter := initGame(&MockInputSource{
+			PlayerInput:       []string{"ann"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"25", ":quit"},
+		})
+		quitter.ProfileStore = profileStore
+		quitter.SaveStore = saveStore
+		quitter.PlayGame(fakeRandomNumber, scoresStore)
+
+		gotWriter, resumer := initGame(&MockInputSource{
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"50", ":giveup"},
+			PlayAgainInput:    []string{"1", "2"},
+		})
+		resumer.ProfileStore = profileStore
+		resumer.SaveStore = saveStore
+		resumer.ResumeGame(scoresStore)
+		got := gotWriter.String()
+
+		assert.Contains(t, got, gameConfig["difficulty"])
+		assert.NotContains(t, got, gameConfig["player"])
+		assert.NotContains(t, got, gameConfig["profile_pick"])
+		assert.Equal(t, "Ann", resumer.Profile.DisplayName)
+		scores := scoresStore.Load()
+		assert.Len(t, scores, 1)
+		assert.Equal(t, 1, scores[0].ProfileID)
+	})
+}
+
+func TestIntegrationGameFairness(t *testing.T) {
+	t.Run("commit to the number, reveal it and verify the recorded round", func(t *testing.T) {
+		recordStore := &StubRecordStore{}
+		gotWriter, fair := initGame(&MockInputSource{
+			PlayerInput:       []string{"test"},
+			DifficultyInput:   []string{"3"},
+			GuessNumberInputs: []string{"25", "50"},
+			PlayAgainInput:    []string{"2"},
+		})
+		fair.RecordStore = recordStore
+		fair.Commit = true
+
+		fair.PlayGame(fakeRandomNumber, stubScoreStore)
+		got := gotWriter.String()
+
+		commitment := recordStore.games[0].Commitment
+		assert.NotNil(t, commitment)
+		assert.True(t, commitment.Verify("50"))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["commitment"], commitment.Hash))
+		assert.Contains(t, got, fmt.Sprintf(gameConfig["reveal"],
+			"50", commitment.Nonce, commitment.Hash, "50", commitment.Nonce,
+		))
+
+		gotWriter, fair = initGame(&MockInputSource{})
+		fair.RecordStore = recordStore
+		fair.VerifyGame(1)
+
+		assert.Contains(t, gotWriter.String(), fmt.Sprintf(gameConfig["verify_fair"],
+			1, commitment.Hash, "50", commitment.Nonce,
+		))
+	})
+
+	t.Run("verify the recorded rounds", func(t *testing.T) {
+		commitment, err := fairness.Commit("50")
+		assert.NoError(t, err)
+
+		testCases := []struct {
+			description string
+			recorded    record.Game
+			want        string
+		}{
+			{
+				description: "the number changed after the commitment",
+				recorded:    record.Game{ID: 1, RandomNumber: 51, Commitment: &commitment},
+				want: fmt.Sprintf(gameConfig["verify_unfair"],
+					commitment.Hash, "51", commitment.Nonce,
+				),
+			},
+			{
+				description: "no commitment",
+				recorded:    record.Game{ID: 1, RandomNumber: 50},
+				want:        fmt.Sprintf(gameConfig["verify_none"], 1),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				gotWriter, verifier := initGame(&MockInputSource{})
+				verifier.RecordStore = &StubRecordStore{games: record.Games{tc.recorded}}
+
+				verifier.VerifyGame(1)
+
+				assert.Contains(t, gotWriter.String(), tc.want)
+			})
+		}
+	})
+
+	t.Run("verify a revealed commitment", func(t *testing.T) {
+		commitment, err := fairness.Commit("50")
+		assert.NoError(t, err)
+
+		testCases := []struct {
+			secret string
+			want   string
+		}{
+			{secret: "50", want: gameConfig["verify_valid"]},
+			{secret: "49", want: gameConfig["verify_unfair"]},
+		}
+
+		for _, tc := range testCases {
+			gotWriter, verifier := initGame(&MockInputSource{})
+
+			verifier.VerifyCommitment(commitment.Hash, tc.secret, commitment.Nonce)
+
+			assert.Contains(t, gotWriter.String(),
+				fmt.Sprintf(tc.want, commitment.Hash, tc.secret, commitment.Nonce),
+			)
+		}
+	})
+
+	t.Run("error when no game has the ID", func(t *testing.T) {
+		gotWriter, verifier := initGame(&MockInputSource{})
+		verifier.RecordStore = &StubRecordStore{}
+
+		verifier.VerifyGame(7)
+
+		assert.Contains(t, gotWriter.String(), record.NewGameNotFoundError(7).Error())
+	})
 }
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/oracle"
+)
+
+// ShowSimulation displays the guesses the lying-oracle solver needs on the
+// default range against an oracle lying as soon as allowed, from no lies up
+// to maxLies, to tune the lies allowed against the attempts of each level.
+func (g *Game) ShowSimulation(maxLies int) {
+	results := oracle.Simulate(
+		game.MinNumber,
+		game.MaxNumber,
+		maxLies,
+		oracle.Liar{Rate: 1},
+	)
+
+	cli.Display(g.Writer, []string{
+		fmt.Sprintf(g.GameConfig["simulation"], game.MinNumber, game.MaxNumber),
+		g.GameConfig["newline"],
+		results.String(),
+		g.GameConfig["newline"],
+	})
+}
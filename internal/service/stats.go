@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/rating"
+)
+
+// ShowStats displays the ratings leaderboard and, when a player is given,
+// the rating history of the player, found by profile when the player has
+// one.
+func (g *Game) ShowStats(player string) {
+	ratings := rating.Ratings{}
+	if g.RatingStore != nil {
+		ratings = g.RatingStore.Load()
+	}
+
+	messages := []string{
+		g.GameConfig["stats_ratings"],
+		g.GameConfig["newline"],
+		ratings.Standings().String(),
+	}
+
+	if player != "" {
+		key := player
+		if g.ProfileStore != nil {
+			if p, ok := g.ProfileStore.Load().Find(player); ok {
+				player, key = p.DisplayName, profile.Key(p.Name, p.ID)
+			}
+		}
+		messages = append(messages,
+			g.GameConfig["newline"],
+			fmt.Sprintf(g.GameConfig["stats_history"], player),
+			g.GameConfig["newline"],
+			ratings[key].String(),
+		)
+	}
+
+	cli.Display(g.Writer, append(messages, g.GameConfig["newline"]))
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/parser"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/go-number-guessing-game/internal/store"
+	"github.com/go-number-guessing-game/internal/tournament"
+)
+
+// NewTournament registers the players for a tournament of the format, on
+// the level of the difficulty choice, replacing any tournament in progress,
+// and displays its bracket. The names of the players must fit NameLimits,
+// and are registered as the display names of their profiles when known.
+func (g *Game) NewTournament(format, choice string, players []string) {
+	level, maxAttempts, err := parser.ParseDifficultyInput(choice)
+	if err == nil {
+		players, err = g.playerNames(players)
+	}
+	var t tournament.Tournament
+	if err == nil {
+		t, err = tournament.New(format, level, maxAttempts, players)
+	}
+	if err == nil && g.TournamentStore != nil {
+		err = g.TournamentStore.Save(t)
+	}
+	if err != nil {
+		g.displayError(err, []string{g.GameConfig["newline"]})
+		return
+	}
+
+	g.displayTournament(t)
+}
+
+// playerNames validates and normalizes the names of the players like the
+// profile picker does.
+func (g *Game) playerNames(players []string) ([]string, error) {
+	var profiles profile.Profiles
+	if g.ProfileStore != nil {
+		profiles = g.ProfileStore.Load()
+	}
+
+	names := make([]string, len(players))
+	for i, player := range players {
+		name, err := g.NameLimits.Parse(player)
+		if err != nil {
+			return nil, err
+		}
+		if p, ok := profiles.Find(name); ok {
+			name = p.DisplayName
+		}
+		names[i] = name
+	}
+	return names, nil
+}
+
+// PlayTournament plays the matches left in the tournament in progress, each
+// player of a match taking a turn at the same number, until the tournament
+// is over or a player quits. On a Terminal, the screen is cleared before
+// the away turn, so that the away player can't see the number found or
+// revealed in the home turn. A round left mid-game counts as lost with every
+// attempt used, so that it can't be played again knowing the narrowed
+// range. The tournament is saved after every round, so that it can be
+// continued later, and its standings are displayed.
+func (g *Game) PlayTournament(gameStore store.Store) {
+	t, err := g.loadTournament()
+	if err != nil {
+		g.displayError(err, []string{g.GameConfig["newline"]})
+		return
+	}
+
+	// A round left mid-game is lost rather than saved to be resumed alone.
+	saveStore := g.SaveStore
+	g.SaveStore = nil
+	defer func() { g.SaveStore = saveStore }()
+
+	for {
+		index, ok := t.Next()
+		if !ok {
+			break
+		}
+
+		match := t.Matches[index]
+		player, _ := match.Next()
+		if g.Terminal && player == match.Away {
+			cli.Display(g.Writer, cli.ClearScreen)
+		}
+		cli.Display(g.Writer, []string{
+			fmt.Sprintf(g.GameConfig["tournament_match"],
+				match.Round,
+				match.Home,
+				match.Away,
+			),
+			g.GameConfig["newline"],
+			fmt.Sprintf(g.GameConfig["tournament_turn"],
+				player,
+				t.Level,
+				t.MaxAttempts,
+			),
+			g.GameConfig["spacer"],
+		})
+
+		result := g.playRound(engine.Start{
+			Player:       player,
+			Level:        t.Level,
+			MaxAttempts:  t.MaxAttempts,
+			RandomNumber: match.Number(),
+		}, gameStore)
+		if result.quit && !result.left {
+			break
+		}
+		if result.left {
+			result.attempts = t.MaxAttempts
+			cli.Display(g.Writer, []string{
+				fmt.Sprintf(g.GameConfig["tournament_left"], player),
+				g.GameConfig["spacer"],
+			})
+		}
+
+		t.Record(index, player, tournament.Result{
+			Won:      result.found,
+			Attempts: result.attempts,
+			Time:     result.time,
+		})
+		if err := g.TournamentStore.Save(t); err != nil {
+			g.displayError(err, []string{g.GameConfig["newline"]})
+			return
+		}
+
+		if match = t.Matches[index]; match.Played {
+			message := g.GameConfig["tournament_draw"]
+			if match.Winner != "" {
+				message = fmt.Sprintf(g.GameConfig["tournament_winner"],
+					match.Winner,
+				)
+			}
+			cli.Display(g.Writer, []string{message, g.GameConfig["spacer"]})
+		}
+		if result.left {
+			break
+		}
+	}
+
+	g.displayTournament(t)
+}
+
+// ShowTournament displays the bracket and standings of the tournament in
+// progress.
+func (g *Game) ShowTournament() {
+	t, err := g.loadTournament()
+	if err != nil {
+		g.displayError(err, []string{g.GameConfig["newline"]})
+		return
+	}
+
+	g.displayTournament(t)
+}
+
+func (g *Game) loadTournament() (tournament.Tournament, error) {
+	if g.TournamentStore == nil {
+		return tournament.Tournament{}, tournament.NewNoTournamentError()
+	}
+	return g.TournamentStore.Load()
+}
+
+// displayTournament displays the bracket and the standings of the
+// tournament, and its champion once it is over.
+func (g *Game) displayTournament(t tournament.Tournament) {
+	messages := []string{
+		g.GameConfig["tournament_bracket"],
+		g.GameConfig["newline"],
+		t.Bracket(),
+		g.GameConfig["newline"],
+		g.GameConfig["tournament_standings"],
+		g.GameConfig["newline"],
+		t.Standings().String(),
+	}
+
+	if champion := t.Champion(); champion != "" {
+		messages = append(messages,
+			g.GameConfig["newline"],
+			fmt.Sprintf(g.GameConfig["tournament_champion"], champion),
+			g.GameConfig["newline"],
+		)
+	}
+
+	cli.Display(g.Writer, append(messages, g.GameConfig["newline"]))
+}
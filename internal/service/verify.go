@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/fairness"
+	"github.com/go-number-guessing-game/internal/record"
+)
+
+// VerifyGame checks that the recorded game with the ID kept the secret it
+// committed to when it started.
+func (g *Game) VerifyGame(id int) {
+	var games record.Games
+	if g.RecordStore != nil {
+		games = g.RecordStore.Load()
+	}
+
+	recorded, err := games.Find(id)
+	if err != nil {
+		g.displayError(err, []string{
+			g.GameConfig["newline"],
+		})
+		return
+	}
+
+	if recorded.Commitment == nil {
+		cli.Display(g.Writer, []string{
+			fmt.Sprintf(g.GameConfig["verify_none"], recorded.ID),
+			g.GameConfig["newline"],
+		})
+		return
+	}
+
+	state := recorded.State()
+	secret := state.Secret()
+	message := fmt.Sprintf(g.GameConfig["verify_fair"],
+		recorded.ID,
+		recorded.Commitment.Hash,
+		secret,
+		recorded.Commitment.Nonce,
+	)
+	if !recorded.Verify() {
+		message = fmt.Sprintf(g.GameConfig["verify_unfair"],
+			recorded.Commitment.Hash,
+			secret,
+			recorded.Commitment.Nonce,
+		)
+	}
+
+	cli.Display(g.Writer, []string{
+		message,
+		g.GameConfig["newline"],
+	})
+}
+
+// VerifyCommitment checks that the commitment shown when a round started
+// hashes the secret and the nonce revealed at its end.
+func (g *Game) VerifyCommitment(hash, secret, nonce string) {
+	message := g.GameConfig["verify_valid"]
+	if !fairness.Verify(hash, secret, nonce) {
+		message = g.GameConfig["verify_unfair"]
+	}
+
+	cli.Display(g.Writer, []string{
+		fmt.Sprintf(message, hash, secret, nonce),
+		g.GameConfig["newline"],
+	})
+}
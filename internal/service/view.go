@@ -0,0 +1,280 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-number-guessing-game/internal/cli"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/go-number-guessing-game/internal/tui"
+)
+
+// view subscribes to the engine to render a round in the CLI, either as
+// plain lines or as full-screen frames when the game has a screen. Messages
+// accumulate from one guess to the next so a frame shows them all. When
+// announcing, the progress is announced after every guess as in accessible
+// mode.
+type view struct {
+	game       *Game
+	engine     *engine.Engine
+	messages   []string
+	announcing bool
+}
+
+// Notify renders the event with the messages from the game config.
+func (v *view) Notify(event engine.Event) {
+	g := v.game
+
+	switch e := event.(type) {
+	case engine.GameStarted:
+		commitment := v.commitment()
+		if g.Screen != nil {
+			v.messages = append(
+				[]string{fmt.Sprintf(g.GameConfig["level"], e.Level)},
+				commitment...,
+			)
+			v.draw()
+			break
+		}
+
+		if len(commitment) > 0 {
+			cli.Display(g.Writer, append(commitment[1:], g.GameConfig["spacer"]))
+		}
+
+	case engine.GameResumed:
+		resumed := fmt.Sprintf(g.GameConfig["resumed"], e.Level, e.Remaining)
+		if g.Screen != nil {
+			v.messages = append([]string{resumed}, v.commitment()...)
+			v.draw()
+			break
+		}
+
+		messages := append([]string{resumed}, v.commitment()...)
+		if history := g.history(e.Turns); len(history) > 0 {
+			messages = append(messages, g.GameConfig["newline"])
+			messages = append(messages, history[:len(history)-1]...)
+		}
+		cli.Display(g.Writer, append(messages, g.GameConfig["spacer"]))
+
+	case engine.GuessEvaluated:
+		v.messages = nil
+		multi := v.engine.State().Variant == game.MultiVariant
+
+		switch *e.Turn.Outcome {
+		case game.Greater, game.Less:
+			key := e.Turn.Outcome.String()
+			message := g.GameConfig[key]
+			if multi {
+				message = g.GameConfig["nearest_"+key]
+			}
+			v.report(
+				g.Theme.Paint(key, fmt.Sprintf(message, e.Turn.GuessNumber)),
+				g.GameConfig["newline"],
+			)
+
+		case game.Hit:
+			v.report(
+				g.Theme.Paint("equal",
+					fmt.Sprintf(g.GameConfig["hit"], e.Turn.GuessNumber),
+				),
+				g.GameConfig["newline"],
+			)
+
+		case game.Mismatch:
+			v.report(
+				fmt.Sprintf(g.GameConfig["mismatch"],
+					e.Turn.GuessNumber,
+					e.Turn.Bulls,
+					e.Turn.Cows,
+				),
+				g.GameConfig["newline"],
+			)
+		}
+
+		// The multi variant tells how many secrets are left on each side.
+		if left := e.Turn.Above + e.Turn.Below; multi && left > 0 {
+			v.report(
+				fmt.Sprintf(g.GameConfig["secrets_left"],
+					left,
+					e.Turn.Above,
+					e.Turn.Below,
+				),
+				g.GameConfig["newline"],
+			)
+		}
+
+	case engine.HintIssued:
+		var messages []string
+		if e.Key != "" {
+			message := g.GameConfig[e.Key]
+			if len(e.Args) > 0 {
+				message = fmt.Sprintf(message, e.Args...)
+			}
+			messages = append(messages, g.Theme.Paint(e.Key, message))
+		}
+		messages = append(messages, v.announce()...)
+		v.report(append(messages, g.GameConfig["spacer"])...)
+
+	case engine.ClueIssued:
+		v.messages = nil
+		messages := append([]string{v.clue(e)}, v.announce()...)
+		v.report(append(messages, g.GameConfig["spacer"])...)
+
+	case engine.GuessExpired:
+		v.messages = nil
+		v.breakPrompt()
+		messages := []string{
+			g.Theme.Paint("error", g.GameConfig["guess_expired"]),
+		}
+		messages = append(messages, v.announce()...)
+		v.report(append(messages, g.GameConfig["spacer"])...)
+
+	case engine.GameWon:
+		v.report(
+			g.Theme.Paint("equal",
+				fmt.Sprintf(g.GameConfig["equal"], e.Time.String(), e.Attempts),
+			),
+			g.GameConfig["newline"],
+		)
+		v.reveal()
+
+	case engine.GameLost:
+		message := g.GameConfig["max_attempts"]
+		switch {
+		case e.GaveUp:
+			message = fmt.Sprintf(g.GameConfig["gave_up"], e.RandomNumber)
+		case e.TimedOut:
+			v.breakPrompt()
+			message = fmt.Sprintf(g.GameConfig["time_up"], e.RandomNumber)
+		}
+		v.report(
+			g.Theme.Paint("max_attempts", message),
+			g.GameConfig["newline"],
+		)
+
+		if len(e.Secrets) > 0 {
+			secrets := make([]string, len(e.Secrets))
+			for i, secret := range e.Secrets {
+				secrets[i] = strconv.Itoa(secret)
+			}
+			v.report(
+				fmt.Sprintf(g.GameConfig["secrets"], strings.Join(secrets, ", ")),
+				g.GameConfig["newline"],
+			)
+		}
+		v.reveal()
+
+	case engine.LiesRevealed:
+		var lies []string
+		for i, turn := range e.Turns {
+			if turn.Lied {
+				truth := -*turn.Outcome
+				lies = append(lies, fmt.Sprintf(g.GameConfig["lie"],
+					i+1,
+					turn.GuessNumber,
+					truth.String(),
+				))
+			}
+		}
+
+		messages := []string{
+			fmt.Sprintf(g.GameConfig["lies_revealed"], len(lies), e.MaxLies),
+			g.GameConfig["newline"],
+		}
+		for _, lie := range lies {
+			messages = append(messages, lie, g.GameConfig["newline"])
+		}
+		v.report(messages...)
+	}
+}
+
+// report displays the messages, either as plain lines or inside the
+// full-screen frame along with the previous messages of the guess.
+func (v *view) report(messages ...string) {
+	v.messages = append(v.messages, messages...)
+	if v.game.Screen == nil {
+		cli.Display(v.game.Writer, messages)
+		return
+	}
+
+	v.draw()
+}
+
+// commitment returns the fairness commitment to show when the round starts,
+// after a newline, and nothing when the round wasn't committed to.
+func (v *view) commitment() []string {
+	commitment := v.engine.Commitment()
+	if commitment.Hash == "" {
+		return nil
+	}
+
+	return []string{
+		v.game.GameConfig["newline"],
+		fmt.Sprintf(v.game.GameConfig["commitment"], commitment.Hash),
+	}
+}
+
+// reveal reports the secret and the nonce of the fairness commitment at the
+// end of the round, with the command verifying them.
+func (v *view) reveal() {
+	commitment := v.engine.Commitment()
+	if commitment.Hash == "" {
+		return
+	}
+
+	gameState := v.engine.State()
+	v.report(
+		fmt.Sprintf(v.game.GameConfig["reveal"],
+			gameState.Secret(),
+			commitment.Nonce,
+			commitment.Hash,
+			gameState.Secret(),
+			commitment.Nonce,
+		),
+		v.game.GameConfig["newline"],
+	)
+}
+
+// breakPrompt ends the line of the prompt left unanswered when a deadline
+// passed, in line mode.
+func (v *view) breakPrompt() {
+	if v.game.Screen == nil {
+		cli.Display(v.game.Writer, v.game.GameConfig["newline"])
+	}
+}
+
+func (v *view) draw() {
+	v.game.Screen.Draw(tui.NewFrame(
+		v.engine.Player(),
+		v.engine.State(),
+		v.engine.Elapsed(),
+		v.messages,
+	))
+}
+
+// announce returns the remaining attempts and possible range to read after
+// a guess in accessible or announcing mode, and nothing otherwise.
+func (v *view) announce() []string {
+	if !v.game.Accessible && !v.announcing {
+		return nil
+	}
+
+	gameState := v.engine.State()
+	low, high := gameState.PossibleRange()
+	return []string{
+		v.game.GameConfig["newline"],
+		fmt.Sprintf(v.game.GameConfig["announce"],
+			gameState.MaxAttempts-gameState.GetAttempts(),
+			low,
+			high,
+		),
+	}
+}
+
+func (v *view) clue(e engine.ClueIssued) string {
+	given := hint.Multiple(e.Divisor, e.Multiple)
+	return fmt.Sprintf(v.game.GameConfig[given.Key], given.Args...)
+}
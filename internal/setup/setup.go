@@ -0,0 +1,118 @@
+// Package setup builds the rounds of a game variant: the range, attempts,
+// secrets and lies of every new round, and the engine that plays them with
+// the hints of its level.
+package setup
+
+import (
+	"cmp"
+	"math/rand/v2"
+
+	"github.com/go-number-guessing-game/internal/adaptive"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/hint"
+	"github.com/go-number-guessing-game/internal/oracle"
+)
+
+// Builder builds the rounds of Variant, the classic game when empty.
+// CodeDigits is the length of the codes of game.CodeVariant,
+// game.DefaultCodeDigits when zero, Secrets the count of numbers to find in
+// game.MultiVariant, game.DefaultSecrets when zero, and Seed draws the moves
+// of game.DriftVariant, a random one when zero. Hints sets the hint strategy
+// of each level, and Liar the lies of the lying-oracle mode; its zero value
+// tells no lie.
+type Builder struct {
+	Variant    string
+	CodeDigits int
+	Secrets    int
+	Seed       uint64
+	Hints      hint.Strategies
+	Liar       oracle.Liar
+}
+
+// Engine returns an engine giving the hints and telling the lies of the
+// builder.
+func (b Builder) Engine() *engine.Engine {
+	return &engine.Engine{Hints: b.Hints, Oracle: b.Liar}
+}
+
+// Bounds returns the range of the rounds of the variant before the adaptive
+// level tunes it: the range of the codes in the code variant, and the usual
+// range otherwise, as well as when the codes can't have the digits.
+func (b Builder) Bounds() (int, int) {
+	digits := cmp.Or(b.CodeDigits, game.DefaultCodeDigits)
+	if b.Variant == game.CodeVariant &&
+		digits >= game.MinCodeDigits && digits <= game.MaxCodeDigits {
+		return game.CodeBounds(digits)
+	}
+	return game.MinNumber, game.MaxNumber
+}
+
+// Start returns the command starting a round of the player on the level,
+// whose number is randomNumber unless it is out of the range of the round.
+// The code variant plays codes with the attempts of the level, and the
+// adaptive level tunes the attempts and range of the round to the results
+// of the previous adaptive rounds of the player. It returns a
+// CodeDigitsError or a SecretsError when the variant can't be played with
+// the settings of the builder.
+func (b Builder) Start(
+	player, level string,
+	maxAttempts, randomNumber int,
+	results []adaptive.Result,
+) (engine.Start, error) {
+	var minimum, maximum int
+	switch {
+	case b.Variant == game.CodeVariant:
+		digits := cmp.Or(b.CodeDigits, game.DefaultCodeDigits)
+		if digits < game.MinCodeDigits || digits > game.MaxCodeDigits {
+			return engine.Start{}, game.NewCodeDigitsError()
+		}
+		minimum, maximum = game.CodeBounds(digits)
+		if level == game.AdaptiveLevel {
+			maxAttempts = adaptive.DefaultTuner.Initial.MaxAttempts
+		}
+
+	case level == game.AdaptiveLevel:
+		parameters := adaptive.DefaultTuner.Tune(results)
+		maxAttempts = parameters.MaxAttempts
+		minimum, maximum = parameters.Min, parameters.Max
+	}
+
+	bounds := game.GameState{Min: minimum, Max: maximum}
+	low, high := bounds.Bounds()
+	if randomNumber < low || randomNumber > high {
+		randomNumber = game.NewRandomNumberBetween(low, high)
+	}
+
+	start := engine.Start{
+		Player:       player,
+		Level:        level,
+		MaxAttempts:  maxAttempts,
+		RandomNumber: randomNumber,
+		Min:          minimum,
+		Max:          maximum,
+		Variant:      b.Variant,
+	}
+
+	// The lies are only told about the single number of the plain game, the
+	// multi variant hides more numbers along with the drawn one, and the
+	// drift variant moves the number as drawn from the seed, so that a
+	// round can be played again the same.
+	switch b.Variant {
+	case "":
+		start.MaxLies = b.Liar.For(level)
+
+	case game.MultiVariant:
+		count := cmp.Or(b.Secrets, game.DefaultSecrets)
+		secrets, err := game.NewSecrets(count, randomNumber, low, high)
+		if err != nil {
+			return engine.Start{}, err
+		}
+		start.Secrets = secrets
+
+	case game.DriftVariant:
+		start.Seed = cmp.Or(b.Seed, rand.Uint64())
+	}
+
+	return start, nil
+}
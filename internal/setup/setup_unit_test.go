@@ -0,0 +1,176 @@
+package setup_test
+
+import (
+	"testing"
+
+	"github.com/go-number-guessing-game/internal/adaptive"
+	"github.com/go-number-guessing-game/internal/engine"
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/oracle"
+	"github.com/go-number-guessing-game/internal/setup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBuilderBounds(t *testing.T) {
+	t.Run("return the range of the variant", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			builder     setup.Builder
+			wantLow     int
+			wantHigh    int
+		}{
+			{
+				description: "usual range of the number variant",
+				wantLow:     1,
+				wantHigh:    100,
+			},
+			{
+				description: "range of the codes",
+				builder:     setup.Builder{Variant: game.CodeVariant, CodeDigits: 3},
+				wantLow:     100,
+				wantHigh:    999,
+			},
+			{
+				description: "usual range when the codes can't have the digits",
+				builder:     setup.Builder{Variant: game.CodeVariant, CodeDigits: 12},
+				wantLow:     1,
+				wantHigh:    100,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				low, high := tc.builder.Bounds()
+
+				assert.Equal(t, tc.wantLow, low)
+				assert.Equal(t, tc.wantHigh, high)
+			})
+		}
+	})
+}
+
+func TestUnitBuilderStart(t *testing.T) {
+	t.Run("start the round of the variant", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			builder     setup.Builder
+			level       string
+			results     []adaptive.Result
+			want        engine.Start
+		}{
+			{
+				description: "number round with lies",
+				builder: setup.Builder{
+					Liar: oracle.Liar{MaxLies: map[string]int{"hard": 1}},
+				},
+				level: "Hard",
+				want: engine.Start{
+					Player:       "ann",
+					Level:        "Hard",
+					MaxAttempts:  3,
+					RandomNumber: 50,
+					MaxLies:      1,
+				},
+			},
+			{
+				description: "code round on the attempts of the level",
+				builder:     setup.Builder{Variant: game.CodeVariant, CodeDigits: 2},
+				level:       "Hard",
+				want: engine.Start{
+					Player:       "ann",
+					Level:        "Hard",
+					MaxAttempts:  3,
+					RandomNumber: 50,
+					Min:          10,
+					Max:          99,
+					Variant:      game.CodeVariant,
+				},
+			},
+			{
+				description: "adaptive round tuned to the results",
+				level:       game.AdaptiveLevel,
+				results: []adaptive.Result{
+					{Won: false, Attempts: 10, MaxAttempts: 10, Min: 1, Max: 100},
+				},
+				want: engine.Start{
+					Player:       "ann",
+					Level:        game.AdaptiveLevel,
+					MaxAttempts:  11,
+					RandomNumber: 50,
+					Min:          1,
+					Max:          100,
+				},
+			},
+			{
+				description: "drift round on the seed",
+				builder:     setup.Builder{Variant: game.DriftVariant, Seed: 7},
+				level:       "Hard",
+				want: engine.Start{
+					Player:       "ann",
+					Level:        "Hard",
+					MaxAttempts:  3,
+					RandomNumber: 50,
+					Variant:      game.DriftVariant,
+					Seed:         7,
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got, err := tc.builder.Start("ann", tc.level, 3, 50, tc.results)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("draw the number in the range of the round", func(t *testing.T) {
+		builder := setup.Builder{Variant: game.CodeVariant, CodeDigits: 4}
+
+		got, err := builder.Start("ann", "Hard", 3, 50, nil)
+
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, got.RandomNumber, 1000)
+		assert.LessOrEqual(t, got.RandomNumber, 9999)
+	})
+
+	t.Run("hide the secrets of the multi variant", func(t *testing.T) {
+		builder := setup.Builder{Variant: game.MultiVariant, Secrets: 3}
+
+		got, err := builder.Start("ann", "Easy", 10, 50, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, got.Secrets, 3)
+		assert.Equal(t, 50, got.Secrets[0])
+	})
+
+	t.Run("error when the variant can't be played", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			builder     setup.Builder
+			want        error
+		}{
+			{
+				description: "too many digits",
+				builder:     setup.Builder{Variant: game.CodeVariant, CodeDigits: 12},
+				want:        game.NewCodeDigitsError(),
+			},
+			{
+				description: "too many secrets",
+				builder:     setup.Builder{Variant: game.MultiVariant, Secrets: 200},
+				want:        game.NewSecretsError(),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				_, got := tc.builder.Start("ann", "Easy", 10, 50, nil)
+
+				assert.ErrorAs(t, got, &tc.want)
+				assert.Equal(t, tc.want.Error(), got.Error())
+			})
+		}
+	})
+}
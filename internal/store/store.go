@@ -5,9 +5,12 @@ package store
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
@@ -17,13 +20,82 @@ import (
 // public for testing purposes.
 const NoScores = "No scores yet. Please guess the number to start scoring."
 
+// ScoreSentence formats one score as a linear sentence for screen readers.
+// It is public for testing purposes.
+const ScoreSentence = "Rank %d: %s, %s level, %d attempts in %v."
+
+// PointsSentence is appended to a score sentence when the score has points.
+// It is public for testing purposes.
+const PointsSentence = " %d points."
+
+// VariantSentence is appended to a score sentence when the score has a
+// variant. It is public for testing purposes.
+const VariantSentence = " %s variant."
+
+// Ranking names the order of the leaderboard.
+type Ranking string
+
+// Rankings of the leaderboard: by level, then attempts, then time, or by
+// points, then time. Both keep the scores of each variant together, the
+// number variant first.
+const (
+	RankingLevel  Ranking = "level"
+	RankingPoints Ranking = "points"
+)
+
+// RankingError represents an error occurring when no ranking of the
+// leaderboard has the name.
+type RankingError struct {
+	Name string
+}
+
+// RankingMessage is the message displayed when the ranking is unknown. It
+// is public for testing purposes.
+const RankingMessage = "No ranking is named %q: choose %s or %s."
+
+// Error returns a message listing the names of the rankings.
+func (e *RankingError) Error() string {
+	return fmt.Sprintf(RankingMessage, e.Name, RankingLevel, RankingPoints)
+}
+
+// NewRankingError creates a new RankingError for testing.
+func NewRankingError(name string) error {
+	return &RankingError{Name: name}
+}
+
+// ParseRanking returns the ranking named name. It returns a RankingError
+// when no ranking has the name.
+func ParseRanking(name string) (Ranking, error) {
+	switch ranking := Ranking(name); ranking {
+	case RankingLevel, RankingPoints:
+		return ranking, nil
+	}
+	return "", NewRankingError(name)
+}
+
 // Score represents a player's game performance, including their name,
 // difficulty level, number of attempts, and time taken for the session.
+// Points are computed by the scoring formula, and zero for older scores.
+// MaxAttempts, Min and Max record the parameters the round was played with,
+// which vary between rounds of the adaptive level. Efficiency compares the
+// guesses with a binary search, as a percentage. Variant is the variant of
+// the game, empty for the number variant, so that the leaderboard doesn't mix
+// variants. Season is the season the round was played in, scores from
+// before seasons belonging to the first one. ProfileID references the
+// profile of the player, zero when the player has none.
 type Score struct {
-	Player   string        `json:"player"`
-	Level    string        `json:"level"`
-	Attempts int           `json:"attempts"`
-	Time     time.Duration `json:"time"`
+	Player      string        `json:"player"`
+	Level       string        `json:"level"`
+	Variant     string        `json:"variant,omitempty"`
+	Attempts    int           `json:"attempts"`
+	Time        time.Duration `json:"time"`
+	Points      int           `json:"points,omitempty"`
+	MaxAttempts int           `json:"max_attempts,omitempty"`
+	Min         int           `json:"min,omitempty"`
+	Max         int           `json:"max,omitempty"`
+	Efficiency  int           `json:"efficiency,omitempty"`
+	Season      int           `json:"season,omitempty"`
+	ProfileID   int           `json:"profile_id,omitempty"`
 }
 
 // Scores is a collection of Score entries, providing a method to format
@@ -33,27 +105,85 @@ type Scores []Score
 // String formats the Scores collection into an ASCII table. If no scores
 // are present, it returns a message indicating that no scores are available.
 func (s Scores) String() string {
+	return s.Render(nil)
+}
+
+// Render formats the Scores collection like String, styling the header with
+// the given ANSI SGR codes when any are provided. A Variant column is added
+// once any score has a variant, and a Points column once any score has
+// points.
+func (s Scores) Render(header []int) string {
 	if len(s) == 0 {
 		return NoScores
 	}
 
 	var buffer bytes.Buffer
 	table := tablewriter.NewWriter(&buffer)
-	table.SetHeader([]string{"Player", "Level", "Attempts", "Time"})
+	headers := []string{"Player", "Level", "Attempts", "Time"}
+	if s.hasVariants() {
+		headers = slices.Insert(headers, 2, "Variant")
+	}
+	if s.hasPoints() {
+		headers = append(headers, "Points")
+	}
+	table.SetHeader(headers)
+
+	if len(header) > 0 {
+		colors := make([]tablewriter.Colors, len(headers))
+		for i := range colors {
+			colors[i] = header
+		}
+		table.SetHeaderColor(colors...)
+	}
 
 	for _, score := range s {
-		table.Append([]string{
+		row := []string{
 			score.Player,
 			score.Level,
 			strconv.Itoa(score.Attempts),
 			score.Time.String(),
-		})
+		}
+		if s.hasVariants() {
+			row = slices.Insert(row, 2, score.Variant)
+		}
+		if s.hasPoints() {
+			row = append(row, strconv.Itoa(score.Points))
+		}
+		table.Append(row)
 	}
 
 	table.Render()
 	return buffer.String()
 }
 
+// Sentences formats the Scores collection as one linear sentence per score,
+// without any box drawing, for screen readers. If no scores are present, it
+// returns a message indicating that no scores are available.
+func (s Scores) Sentences() string {
+	if len(s) == 0 {
+		return NoScores
+	}
+
+	sentences := make([]string, len(s))
+	for i, score := range s {
+		sentences[i] = fmt.Sprintf(ScoreSentence,
+			i+1,
+			score.Player,
+			score.Level,
+			score.Attempts,
+			score.Time,
+		)
+		if score.Variant != "" {
+			sentences[i] += fmt.Sprintf(VariantSentence, score.Variant)
+		}
+		if score.Points > 0 {
+			sentences[i] += fmt.Sprintf(PointsSentence, score.Points)
+		}
+	}
+
+	return strings.Join(sentences, "\n")
+}
+
 // Store defines methods for loading and adding scores, facilitating testing.
 type Store interface {
 	Load() Scores
@@ -61,14 +191,37 @@ type Store interface {
 }
 
 // ScoresStore manages the file path for storing scores, which must be a
-// JSON file.
+// JSON file. Ranking orders the top scores returned by Add, by level when
+// unset. When Season is set, the store is scoped to the season: scores are
+// added to it, and only its scores are loaded and ranked.
 type ScoresStore struct {
 	FilePath string
+	Ranking  Ranking
+	Season   int
 }
 
-// Load retrieves previously saved scores. If no scores exist, it returns
-// an empty Scores collection.
+// Load retrieves previously saved scores of the season of the store. If no
+// scores exist, it returns an empty Scores collection.
 func (s *ScoresStore) Load() Scores {
+	scores := s.loadAll()
+	if s.Season > 0 {
+		return scores.InSeason(s.Season)
+	}
+	return scores
+}
+
+// InSeason returns the scores of the season, in the same order.
+func (s Scores) InSeason(season int) Scores {
+	scores := Scores{}
+	for _, score := range s {
+		if max(score.Season, 1) == season {
+			scores = append(scores, score)
+		}
+	}
+	return scores
+}
+
+func (s *ScoresStore) loadAll() Scores {
 	byt, err := os.ReadFile(s.FilePath)
 	if err != nil {
 		return Scores{}
@@ -82,9 +235,9 @@ func (s *ScoresStore) Load() Scores {
 	return scores
 }
 
-// Add inserts a new score into the collection, returning the top 10 scores
-// sorted by difficulty, attempts, and time. It handles errors from file
-// operations and JSON marshaling.
+// Add inserts a new score into the collection, in the season of the store,
+// returning the top 10 scores of the season in the order of the store
+// ranking. It handles errors from file operations and JSON marshaling.
 func (s *ScoresStore) Add(score Score) (Scores, error) {
 	file, err := os.OpenFile(s.FilePath, os.O_CREATE|os.O_WRONLY, 0o644)
 	if err != nil {
@@ -92,7 +245,11 @@ func (s *ScoresStore) Add(score Score) (Scores, error) {
 	}
 	defer file.Close()
 
-	scores := s.Load()
+	if s.Season > 0 {
+		score.Season = s.Season
+	}
+
+	scores := s.loadAll()
 	for _, s := range scores {
 		if s == score {
 			return scores, nil
@@ -111,23 +268,73 @@ func (s *ScoresStore) Add(score Score) (Scores, error) {
 		return Scores{}, err
 	}
 
-	scores.sort()
+	if s.Season > 0 {
+		scores = scores.InSeason(s.Season)
+	}
+	return scores.Rank(s.Ranking, 10), nil
+}
+
+// Rank returns the n best scores in the order of the ranking, leaving the
+// collection unchanged. An unknown ranking orders by level.
+func (s Scores) Rank(ranking Ranking, n int) Scores {
+	scores := make(Scores, len(s))
+	copy(scores, s)
+
+	if ranking == RankingPoints {
+		scores.sortByPoints()
+	} else {
+		scores.sort()
+	}
+
+	if len(scores) > n {
+		return scores[0:n]
+	}
+
+	return scores
+}
+
+func (s Scores) hasVariants() bool {
+	for _, score := range s {
+		if score.Variant != "" {
+			return true
+		}
+	}
+	return false
+}
 
-	if len(scores) > 10 {
-		return scores[0:10], nil
+func (s Scores) hasPoints() bool {
+	for _, score := range s {
+		if score.Points > 0 {
+			return true
+		}
 	}
+	return false
+}
 
-	return scores, nil
+func (s *Scores) sortByPoints() {
+	sort.SliceStable(*s, func(i, j int) bool {
+		if (*s)[i].Variant != (*s)[j].Variant {
+			return (*s)[i].Variant < (*s)[j].Variant
+		}
+		if (*s)[i].Points != (*s)[j].Points {
+			return (*s)[i].Points > (*s)[j].Points
+		}
+		return (*s)[i].Time < (*s)[j].Time
+	})
 }
 
 func (s *Scores) sort() {
 	levelOrder := map[string]int{
-		"Hard":   1,
-		"Medium": 2,
-		"Easy":   3,
+		"Hard":     1,
+		"Medium":   2,
+		"Easy":     3,
+		"Adaptive": 4,
 	}
 
 	sort.Slice(*s, func(i, j int) bool {
+		if (*s)[i].Variant != (*s)[j].Variant {
+			return (*s)[i].Variant < (*s)[j].Variant
+		}
 		if levelOrder[(*s)[i].Level] != levelOrder[(*s)[j].Level] {
 			return levelOrder[(*s)[i].Level] < levelOrder[(*s)[j].Level]
 		}
@@ -2,6 +2,7 @@ package store_test
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
 	"os"
 	"strconv"
@@ -38,6 +39,41 @@ func TestIntegrationScoresString(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 
+	t.Run("return scores table with styled header", func(t *testing.T) {
+		scores := store.Scores{createRandomScore(t)}
+
+		got := scores.Render([]int{1})
+
+		assert.Contains(t, got, "\x1b[1mPLAYER\x1b[0m")
+	})
+
+	t.Run("return scores table with points", func(t *testing.T) {
+		scores := store.Scores{{
+			Player:   "Test",
+			Level:    "Hard",
+			Attempts: 2,
+			Time:     20 * time.Second,
+			Points:   530,
+		}}
+
+		got := scores.String()
+
+		assert.Contains(t, got, "POINTS")
+		assert.Contains(t, got, "530")
+	})
+
+	t.Run("return scores table with variants", func(t *testing.T) {
+		scores := store.Scores{
+			{Player: "Test1", Level: "Hard", Attempts: 2},
+			{Player: "Test2", Level: "Easy", Variant: "code", Attempts: 6},
+		}
+
+		got := scores.String()
+
+		assert.Contains(t, got, "| LEVEL | VARIANT | ATTEMPTS |")
+		assert.Contains(t, got, "| Easy  | code    |")
+	})
+
 	t.Run("return message to user when no scores", func(t *testing.T) {
 		scores := store.Scores{}
 
@@ -48,6 +84,49 @@ func TestIntegrationScoresString(t *testing.T) {
 	})
 }
 
+func TestIntegrationScoresSentences(t *testing.T) {
+	t.Run("return one sentence per score", func(t *testing.T) {
+		scores := store.Scores{
+			{Player: "Test1", Level: "Hard", Attempts: 2, Time: 20 * time.Second},
+			{Player: "Test2", Level: "Easy", Attempts: 5, Time: 50 * time.Second},
+		}
+
+		want := fmt.Sprintf(store.ScoreSentence, 1, "Test1", "Hard", 2, "20s") +
+			"\n" + fmt.Sprintf(store.ScoreSentence, 2, "Test2", "Easy", 5, "50s")
+		got := scores.Sentences()
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("read the points of a score", func(t *testing.T) {
+		scores := store.Scores{
+			{Player: "Test", Level: "Hard", Attempts: 2, Time: 20 * time.Second, Points: 530},
+		}
+
+		want := fmt.Sprintf(store.ScoreSentence, 1, "Test", "Hard", 2, "20s") +
+			fmt.Sprintf(store.PointsSentence, 530)
+
+		assert.Equal(t, want, scores.Sentences())
+	})
+
+	t.Run("read the variant of a score", func(t *testing.T) {
+		scores := store.Scores{
+			{Player: "Test", Level: "Easy", Variant: "code", Attempts: 6, Time: 60 * time.Second},
+		}
+
+		want := fmt.Sprintf(store.ScoreSentence, 1, "Test", "Easy", 6, "1m0s") +
+			fmt.Sprintf(store.VariantSentence, "code")
+
+		assert.Equal(t, want, scores.Sentences())
+	})
+
+	t.Run("return message to user when no scores", func(t *testing.T) {
+		scores := store.Scores{}
+
+		assert.Equal(t, store.NoScores, scores.Sentences())
+	})
+}
+
 func TestIntegrationScoresStoreLoad(t *testing.T) {
 	t.Run("return loaded scores", func(t *testing.T) {
 		file := createTempFile(t)
@@ -175,6 +254,117 @@ func TestIntegrationScoresStoreAdd(t *testing.T) {
 
 		assert.Len(t, got, 10)
 	})
+
+	t.Run("scope the scores to the season of the store", func(t *testing.T) {
+		file := createTempFile(t)
+		before := store.Score{Player: "Test1", Level: "Hard", Attempts: 3}
+		first := store.ScoresStore{FilePath: file.Name()}
+		_, err := first.Add(before)
+		assert.NoError(t, err)
+
+		second := store.ScoresStore{FilePath: file.Name(), Season: 2}
+		score := store.Score{Player: "Test2", Level: "Easy", Attempts: 5}
+		got, err := second.Add(score)
+		assert.NoError(t, err)
+
+		score.Season = 2
+		assert.Equal(t, store.Scores{score}, got)
+		assert.Equal(t, store.Scores{score}, second.Load())
+		assert.Equal(t, store.Scores{before}, first.Load().InSeason(1))
+		assert.Len(t, first.Load(), 2)
+	})
+}
+
+func TestIntegrationParseRanking(t *testing.T) {
+	t.Run("return the ranking", func(t *testing.T) {
+		for _, want := range []store.Ranking{store.RankingLevel, store.RankingPoints} {
+			got, err := store.ParseRanking(string(want))
+
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("error listing the rankings when unknown", func(t *testing.T) {
+		_, got := store.ParseRanking("speed")
+
+		assert.Equal(t, store.NewRankingError("speed"), got)
+		assert.EqualError(t, got,
+			`No ranking is named "speed": choose level or points.`,
+		)
+	})
+}
+
+func TestIntegrationScoresRank(t *testing.T) {
+	t.Run("rank by points then time", func(t *testing.T) {
+		hard := store.Score{Player: "Test1", Level: "Hard", Points: 300, Time: 9}
+		easy := store.Score{Player: "Test2", Level: "Easy", Points: 450, Time: 9}
+		tie := store.Score{Player: "Test3", Level: "Medium", Points: 300, Time: 5}
+		scores := store.Scores{hard, easy, tie}
+
+		got := scores.Rank(store.RankingPoints, 10)
+
+		assert.Equal(t, store.Scores{easy, tie, hard}, got)
+		assert.Equal(t, store.Scores{hard, easy, tie}, scores)
+	})
+
+	t.Run("return the n best scores by level", func(t *testing.T) {
+		easy := store.Score{Player: "Test1", Level: "Easy", Attempts: 3}
+		hard := store.Score{Player: "Test2", Level: "Hard", Attempts: 3}
+		medium := store.Score{Player: "Test3", Level: "Medium", Attempts: 3}
+		scores := store.Scores{easy, hard, medium}
+
+		got := scores.Rank(store.RankingLevel, 2)
+
+		assert.Equal(t, store.Scores{hard, medium}, got)
+		assert.Equal(t, store.Scores{easy, hard, medium}, scores)
+	})
+
+	t.Run("rank by level by default", func(t *testing.T) {
+		hard := store.Score{Player: "Test1", Level: "Hard", Points: 300}
+		easy := store.Score{Player: "Test2", Level: "Easy", Points: 450}
+		scores := store.Scores{easy, hard}
+
+		assert.Equal(t, store.Scores{hard, easy}, scores.Rank("", 10))
+	})
+
+	t.Run("rank the scores of each variant together", func(t *testing.T) {
+		code := store.Score{Player: "Test1", Level: "Hard", Variant: "code", Attempts: 1}
+		easy := store.Score{Player: "Test2", Level: "Easy", Attempts: 9}
+		hard := store.Score{Player: "Test3", Level: "Hard", Attempts: 3}
+		scores := store.Scores{code, easy, hard}
+
+		assert.Equal(t, store.Scores{hard, easy, code}, scores.Rank("", 10))
+	})
+
+	t.Run("rank the points of each variant together", func(t *testing.T) {
+		code := store.Score{Player: "Test1", Level: "Hard", Variant: "code", Points: 900}
+		drift := store.Score{Player: "Test2", Level: "Hard", Variant: "drift", Points: 700}
+		easy := store.Score{Player: "Test3", Level: "Easy", Points: 100}
+		hard := store.Score{Player: "Test4", Level: "Hard", Points: 500}
+		scores := store.Scores{code, drift, easy, hard}
+
+		got := scores.Rank(store.RankingPoints, 10)
+
+		assert.Equal(t, store.Scores{hard, easy, code, drift}, got)
+	})
+
+	t.Run("return the store ranking when adding", func(t *testing.T) {
+		file := createTempFile(t)
+		scoresStore := store.ScoresStore{
+			FilePath: file.Name(),
+			Ranking:  store.RankingPoints,
+		}
+		hard := store.Score{Player: "Test1", Level: "Hard", Points: 300}
+		easy := store.Score{Player: "Test2", Level: "Easy", Points: 450}
+
+		_, err := scoresStore.Add(hard)
+		assert.NoError(t, err)
+		got, err := scoresStore.Add(easy)
+		assert.NoError(t, err)
+
+		assert.Equal(t, store.Scores{easy, hard}, got)
+	})
 }
 
 func createTempFile(t *testing.T) *os.File {
@@ -19,12 +19,15 @@ func (t *DefaultTimer) Now() time.Time {
 }
 
 // GameTimer manages the start, end, and elapsed time for a game session,
-// allowing for user feedback and score storage.
+// allowing for user feedback and score storage. Offset is time already
+// played before the start, such as before a game was suspended, so that the
+// suspension itself isn't counted.
 type GameTimer struct {
 	Timer       Timer
 	StartTime   *time.Time
 	EndTime     *time.Time
 	ElapsedTime *time.Duration
+	Offset      time.Duration
 }
 
 // Start records the current time as the start time.
@@ -39,7 +42,17 @@ func (g *GameTimer) End() time.Duration {
 	now := g.Now()
 	g.EndTime = &now
 
-	return g.EndTime.Sub(*g.StartTime).Truncate(time.Second)
+	return (g.Offset + g.EndTime.Sub(*g.StartTime)).Truncate(time.Second)
+}
+
+// Elapsed returns the time passed since the start time, truncated to the
+// second, without recording an end time.
+func (g *GameTimer) Elapsed() time.Duration {
+	if g.StartTime == nil {
+		return g.Offset.Truncate(time.Second)
+	}
+
+	return (g.Offset + g.Now().Sub(*g.StartTime)).Truncate(time.Second)
 }
 
 // Now retrieves the current time using the Timer interface for testing.
@@ -47,6 +60,52 @@ func (g *GameTimer) Now() time.Time {
 	return g.Timer.Now()
 }
 
+// ExpiredError represents an error occurring when a deadline passes before
+// an action happens.
+type ExpiredError struct{}
+
+// Error returns a message indicating that the time is up.
+func (e *ExpiredError) Error() string {
+	return "Time is up."
+}
+
+// NewExpiredError creates a new ExpiredError for testing.
+func NewExpiredError() error {
+	return &ExpiredError{}
+}
+
+// Deadline is the time by which an action must happen, such as a guess in
+// time-attack mode. The zero value never expires.
+type Deadline struct {
+	Timer Timer
+	At    time.Time
+}
+
+// NewDeadline creates a Deadline the limit after the current time.
+func NewDeadline(t Timer, limit time.Duration) Deadline {
+	return Deadline{Timer: t, At: t.Now().Add(limit)}
+}
+
+// IsZero reports whether the deadline is unset and never expires.
+func (d Deadline) IsZero() bool {
+	return d.At.IsZero()
+}
+
+// Remaining returns the time left before the deadline, or zero once it has
+// passed.
+func (d Deadline) Remaining() time.Duration {
+	if d.IsZero() {
+		return 0
+	}
+
+	return max(d.At.Sub(d.Timer.Now()), 0)
+}
+
+// Expired reports whether a set deadline has passed.
+func (d Deadline) Expired() bool {
+	return !d.IsZero() && d.Remaining() == 0
+}
+
 // NewGameTimer creates a GameTimer instance with a DefaultTimer for tracking
 // game time.
 func NewGameTimer() GameTimer {
@@ -45,6 +45,58 @@ func TestUnitGameTimer(t *testing.T) {
 	})
 }
 
+func TestUnitGameTimerElapsed(t *testing.T) {
+	t.Run("return the time passed since start", func(t *testing.T) {
+		timer := timer.GameTimer{Timer: &StubTimer{}}
+
+		timer.Start()
+
+		want := 10 * time.Second
+		got := timer.Elapsed()
+
+		assert.Equal(t, want, got)
+		assert.Nil(t, timer.EndTime)
+	})
+
+	t.Run("add the offset played before start", func(t *testing.T) {
+		timer := timer.GameTimer{Timer: &StubTimer{}, Offset: 5 * time.Second}
+
+		timer.Start()
+
+		assert.Equal(t, 15*time.Second, timer.Elapsed())
+		assert.Equal(t, 15*time.Second, timer.End())
+	})
+
+	t.Run("return zero when not started", func(t *testing.T) {
+		timer := timer.NewGameTimer()
+
+		assert.Equal(t, time.Duration(0), timer.Elapsed())
+	})
+}
+
+func TestUnitDeadline(t *testing.T) {
+	t.Run("return the time left before the deadline", func(t *testing.T) {
+		deadline := timer.NewDeadline(&StubTimer{}, 15*time.Second)
+
+		assert.Equal(t, 5*time.Second, deadline.Remaining())
+		assert.False(t, deadline.Expired())
+	})
+
+	t.Run("expire once the deadline passed", func(t *testing.T) {
+		deadline := timer.NewDeadline(&StubTimer{}, 5*time.Second)
+
+		assert.Equal(t, time.Duration(0), deadline.Remaining())
+		assert.True(t, deadline.Expired())
+	})
+
+	t.Run("never expire when unset", func(t *testing.T) {
+		deadline := timer.Deadline{}
+
+		assert.True(t, deadline.IsZero())
+		assert.False(t, deadline.Expired())
+	})
+}
+
 type StubTimer struct {
 	calls int
 }
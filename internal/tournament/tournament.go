@@ -0,0 +1,522 @@
+// Package tournament runs team tournaments of the game. Registered players
+// are drawn into a round-robin or single-elimination bracket, and each match
+// is played as head-to-head rounds of the same number, drawn from the seed
+// of the match. The player who found it in fewer attempts, then in less
+// time, wins the match.
+package tournament
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/profile"
+	"github.com/olekukonko/tablewriter"
+)
+
+// Formats of the brackets.
+const (
+	RoundRobin  = "round_robin"
+	Elimination = "elimination"
+)
+
+// Points of a match in the round-robin standings.
+const (
+	WinPoints  = 3
+	DrawPoints = 1
+)
+
+// FormatError represents an error occurring when the format of a tournament
+// is unknown.
+type FormatError struct {
+	Format string
+}
+
+// FormatMessage is the message displayed when the format is unknown. It is
+// public for testing purposes.
+const FormatMessage = "Unknown tournament format %q, expected %q or %q."
+
+// Error returns the error message for FormatError.
+func (e *FormatError) Error() string {
+	return fmt.Sprintf(FormatMessage, e.Format, RoundRobin, Elimination)
+}
+
+// NewFormatError creates a new FormatError for testing.
+func NewFormatError(format string) error {
+	return &FormatError{Format: format}
+}
+
+// PlayersError represents an error occurring when a tournament isn't given
+// at least two distinct players, names differing only in case or spacing
+// being the same player.
+type PlayersError struct{}
+
+// Error returns a message indicating the players needed.
+func (e *PlayersError) Error() string {
+	return "A tournament needs at least 2 players with distinct names."
+}
+
+// NewPlayersError creates a new PlayersError for testing.
+func NewPlayersError() error {
+	return &PlayersError{}
+}
+
+// LevelError represents an error occurring when a tournament is set on a
+// level without a fixed number of attempts, which wouldn't be the same for
+// both players of a match.
+type LevelError struct{}
+
+// Error returns a message indicating the levels of tournaments.
+func (e *LevelError) Error() string {
+	return "Tournaments are played on the Easy, Medium or Hard level."
+}
+
+// NewLevelError creates a new LevelError for testing.
+func NewLevelError() error {
+	return &LevelError{}
+}
+
+// NoTournamentError represents an error occurring when there is no
+// tournament to continue.
+type NoTournamentError struct{}
+
+// Error returns a message indicating that there is no tournament.
+func (e *NoTournamentError) Error() string {
+	return "No tournament in progress. Please start one with tournament new."
+}
+
+// NewNoTournamentError creates a new NoTournamentError for testing.
+func NewNoTournamentError() error {
+	return &NoTournamentError{}
+}
+
+// Result is the round of a player in a match.
+type Result struct {
+	Won      bool          `json:"won"`
+	Attempts int           `json:"attempts"`
+	Time     time.Duration `json:"time"`
+}
+
+// Beats reports whether the result wins over the other: a found number
+// beats a lost round, then fewer attempts, then less time.
+func (r Result) Beats(other Result) bool {
+	switch {
+	case r.Won != other.Won:
+		return r.Won
+	case r.Attempts != other.Attempts:
+		return r.Attempts < other.Attempts
+	default:
+		return r.Time < other.Time
+	}
+}
+
+// String returns the attempts and time of a found number, and "lost"
+// otherwise.
+func (r Result) String() string {
+	if !r.Won {
+		return "lost"
+	}
+	return fmt.Sprintf("%d in %v", r.Attempts, r.Time.Round(time.Second))
+}
+
+// Match pairs two players of a round of the bracket, playing the number
+// drawn from Seed. Away is empty for a bye, which Home wins without playing.
+// Winner is set once both played, and left empty for a draw.
+type Match struct {
+	Round      int     `json:"round"`
+	Home       string  `json:"home"`
+	Away       string  `json:"away,omitempty"`
+	Seed       uint64  `json:"seed"`
+	HomeResult *Result `json:"home_result,omitempty"`
+	AwayResult *Result `json:"away_result,omitempty"`
+	Winner     string  `json:"winner,omitempty"`
+	Played     bool    `json:"played"`
+}
+
+// Number returns the number of the match in the default range, the same for
+// both players.
+func (m Match) Number() int {
+	random := rand.New(rand.NewPCG(m.Seed, 0))
+	return game.MinNumber + random.IntN(game.MaxNumber-game.MinNumber+1)
+}
+
+// Next returns the player who plays next in the match, home first, and
+// false when the match was played.
+func (m Match) Next() (string, bool) {
+	switch {
+	case m.Played:
+		return "", false
+	case m.HomeResult == nil:
+		return m.Home, true
+	default:
+		return m.Away, true
+	}
+}
+
+// Tournament is a bracket of the players, in the order they were registered,
+// with its matches in the order they are played. Every match is played on
+// the level with MaxAttempts.
+type Tournament struct {
+	Format      string   `json:"format"`
+	Level       string   `json:"level"`
+	MaxAttempts int      `json:"max_attempts"`
+	Players     []string `json:"players"`
+	Matches     []Match  `json:"matches"`
+}
+
+// New registers the players for a tournament of the format on the level.
+// Round robins draw every round at once, where each player meets every
+// other one, while eliminations draw the next round once one is played,
+// giving byes to the first registered players when they aren't a power of
+// two. It returns a FormatError, PlayersError or LevelError when the
+// tournament can't be played.
+func New(format, level string, maxAttempts int, players []string) (Tournament, error) {
+	if format != RoundRobin && format != Elimination {
+		return Tournament{}, NewFormatError(format)
+	}
+
+	registered := map[string]bool{}
+	for _, player := range players {
+		name := profile.Normalize(player)
+		if name == "" || registered[name] {
+			return Tournament{}, NewPlayersError()
+		}
+		registered[name] = true
+	}
+	if len(players) < 2 {
+		return Tournament{}, NewPlayersError()
+	}
+
+	if maxAttempts <= 0 {
+		return Tournament{}, NewLevelError()
+	}
+
+	t := Tournament{
+		Format:      format,
+		Level:       level,
+		MaxAttempts: maxAttempts,
+		Players:     players,
+		Matches:     []Match{},
+	}
+	if format == RoundRobin {
+		t.drawRoundRobin()
+	} else {
+		t.drawElimination(players, 1)
+	}
+
+	return t, nil
+}
+
+// Next returns the index of the next match to play, and false when the
+// tournament is over.
+func (t Tournament) Next() (int, bool) {
+	for i, match := range t.Matches {
+		if !match.Played {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Record records the result of the player in the match at the index. Once
+// both players played, the match is decided, and in an elimination the next
+// round is drawn when every match of the round is decided.
+func (t *Tournament) Record(index int, player string, result Result) {
+	match := &t.Matches[index]
+	if player == match.Home {
+		match.HomeResult = &result
+	} else {
+		match.AwayResult = &result
+	}
+
+	if match.HomeResult == nil || match.AwayResult == nil {
+		return
+	}
+
+	match.Played = true
+	switch {
+	case match.HomeResult.Beats(*match.AwayResult):
+		match.Winner = match.Home
+	case match.AwayResult.Beats(*match.HomeResult):
+		match.Winner = match.Away
+	case t.Format == Elimination:
+		// The player registered first goes through a perfect tie.
+		match.Winner = match.Home
+	}
+
+	if t.Format == Elimination {
+		if winners, ok := t.roundWinners(match.Round); ok && len(winners) > 1 {
+			t.drawElimination(winners, match.Round+1)
+		}
+	}
+}
+
+// Over reports whether every match of the tournament was played.
+func (t Tournament) Over() bool {
+	_, ok := t.Next()
+	return !ok
+}
+
+// Champion returns the winner of the tournament once it is over: the
+// winner of the final of an elimination, and the leader of the standings of
+// a round robin.
+func (t Tournament) Champion() string {
+	if !t.Over() || len(t.Matches) == 0 {
+		return ""
+	}
+
+	if t.Format == Elimination {
+		return t.Matches[len(t.Matches)-1].Winner
+	}
+	return t.Standings()[0].Player
+}
+
+// Standing is the record of a player in the tournament. Attempts and Time
+// add up the rounds played, a lost round counting one attempt more than the
+// level allows.
+type Standing struct {
+	Player   string
+	Played   int
+	Won      int
+	Drawn    int
+	Lost     int
+	Points   int
+	Attempts int
+	Time     time.Duration
+}
+
+// Standings is the table of the tournament, best first.
+type Standings []Standing
+
+// Standings returns the table of the tournament, sorted by points, then by
+// fewer attempts and less time, then by the order of registration. Byes
+// don't count as played.
+func (t Tournament) Standings() Standings {
+	standings := make(Standings, len(t.Players))
+	rows := map[string]*Standing{}
+	for i, player := range t.Players {
+		standings[i].Player = player
+		rows[player] = &standings[i]
+	}
+
+	for _, match := range t.Matches {
+		if !match.Played || match.Away == "" {
+			continue
+		}
+
+		for _, side := range []struct {
+			player string
+			result *Result
+		}{
+			{match.Home, match.HomeResult},
+			{match.Away, match.AwayResult},
+		} {
+			row := rows[side.player]
+			row.Played++
+			row.Time += side.result.Time
+			row.Attempts += side.result.Attempts
+			if !side.result.Won {
+				row.Attempts = row.Attempts - side.result.Attempts + t.MaxAttempts + 1
+			}
+
+			switch match.Winner {
+			case side.player:
+				row.Won++
+				row.Points += WinPoints
+			case "":
+				row.Drawn++
+				row.Points += DrawPoints
+			default:
+				row.Lost++
+			}
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		switch {
+		case a.Points != b.Points:
+			return a.Points > b.Points
+		case a.Attempts != b.Attempts:
+			return a.Attempts < b.Attempts
+		default:
+			return a.Time < b.Time
+		}
+	})
+
+	return standings
+}
+
+// String formats the standings as an ASCII table.
+func (s Standings) String() string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{
+		"Rank", "Player", "Played", "Won", "Drawn", "Lost", "Points",
+	})
+
+	for i, standing := range s {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			standing.Player,
+			strconv.Itoa(standing.Played),
+			strconv.Itoa(standing.Won),
+			strconv.Itoa(standing.Drawn),
+			strconv.Itoa(standing.Lost),
+			strconv.Itoa(standing.Points),
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// Bracket formats the matches drawn so far as an ASCII table, with the
+// results of the matches played.
+func (t Tournament) Bracket() string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetHeader([]string{"Round", "Home", "Away", "Result", "Winner"})
+
+	for _, match := range t.Matches {
+		away, result, winner := match.Away, "", match.Winner
+		switch {
+		case match.Away == "":
+			away = "bye"
+		case match.Played:
+			result = match.HomeResult.String() + " / " + match.AwayResult.String()
+			if winner == "" {
+				winner = "draw"
+			}
+		case match.HomeResult != nil:
+			result = match.HomeResult.String() + " / -"
+		}
+
+		table.Append([]string{
+			strconv.Itoa(match.Round),
+			match.Home,
+			away,
+			result,
+			winner,
+		})
+	}
+
+	table.Render()
+	return buffer.String()
+}
+
+// drawRoundRobin draws every round with the circle method: the first player
+// stays while the others rotate, an odd player out sitting the round.
+func (t *Tournament) drawRoundRobin() {
+	circle := slices.Clone(t.Players)
+	if len(circle)%2 == 1 {
+		circle = append(circle, "")
+	}
+
+	for round := 1; round < len(circle); round++ {
+		for i := range len(circle) / 2 {
+			home, away := circle[i], circle[len(circle)-1-i]
+			if home == "" || away == "" {
+				continue
+			}
+			t.Matches = append(t.Matches, Match{
+				Round: round,
+				Home:  home,
+				Away:  away,
+				Seed:  rand.Uint64(),
+			})
+		}
+
+		last := circle[len(circle)-1]
+		copy(circle[2:], circle[1:len(circle)-1])
+		circle[1] = last
+	}
+}
+
+// drawElimination draws a round of the elimination between the players,
+// best seeded first: the first meets the last, and so on, byes filling the
+// bracket up to a power of two.
+func (t *Tournament) drawElimination(players []string, round int) {
+	size := 1
+	for size < len(players) {
+		size *= 2
+	}
+
+	for i := range size / 2 {
+		match := Match{Round: round, Home: players[i], Seed: rand.Uint64()}
+		if j := size - 1 - i; j < len(players) {
+			match.Away = players[j]
+		} else {
+			match.Winner = match.Home
+			match.Played = true
+		}
+		t.Matches = append(t.Matches, match)
+	}
+}
+
+// roundWinners returns the winners of the matches of the round, in order,
+// and whether they were all played.
+func (t Tournament) roundWinners(round int) ([]string, bool) {
+	var winners []string
+	for _, match := range t.Matches {
+		if match.Round != round {
+			continue
+		}
+		if !match.Played {
+			return nil, false
+		}
+		winners = append(winners, match.Winner)
+	}
+	return winners, true
+}
+
+// Store defines methods for saving and loading the tournament in progress,
+// facilitating testing.
+type Store interface {
+	Save(tournament Tournament) error
+	Load() (Tournament, error)
+}
+
+// FileStore manages the file path of the tournament, which must be a JSON
+// file.
+type FileStore struct {
+	FilePath string
+}
+
+// Save writes the tournament, replacing any previous one.
+func (s *FileStore) Save(tournament Tournament) error {
+	byt, err := json.Marshal(tournament)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.FilePath, byt, 0o644)
+}
+
+// Load reads the tournament. It returns a NoTournamentError when none was
+// started.
+func (s *FileStore) Load() (Tournament, error) {
+	byt, err := os.ReadFile(s.FilePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Tournament{}, NewNoTournamentError()
+	}
+	if err != nil {
+		return Tournament{}, err
+	}
+
+	var tournament Tournament
+	if err = json.Unmarshal(byt, &tournament); err != nil {
+		return Tournament{}, err
+	}
+
+	return tournament, nil
+}
@@ -0,0 +1,240 @@
+package tournament_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/tournament"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationNew(t *testing.T) {
+	t.Run("draw every pair of a round robin once", func(t *testing.T) {
+		players := []string{"ann", "bob", "cid", "dan", "eve"}
+		got, err := tournament.New(tournament.RoundRobin, "Easy", 10, players)
+		assert.NoError(t, err)
+
+		assert.Len(t, got.Matches, 10)
+		pairs := map[[2]string]bool{}
+		perRound := map[int]map[string]bool{}
+		for _, match := range got.Matches {
+			pair := [2]string{min(match.Home, match.Away), max(match.Home, match.Away)}
+			assert.False(t, pairs[pair], "%v met twice", pair)
+			pairs[pair] = true
+
+			if perRound[match.Round] == nil {
+				perRound[match.Round] = map[string]bool{}
+			}
+			assert.False(t, perRound[match.Round][match.Home])
+			assert.False(t, perRound[match.Round][match.Away])
+			perRound[match.Round][match.Home] = true
+			perRound[match.Round][match.Away] = true
+		}
+		assert.Len(t, perRound, 5)
+	})
+
+	t.Run("give byes to the first players of an elimination", func(t *testing.T) {
+		players := []string{"ann", "bob", "cid", "dan", "eve"}
+		got, err := tournament.New(tournament.Elimination, "Easy", 10, players)
+		assert.NoError(t, err)
+
+		want := []tournament.Match{
+			{Round: 1, Home: "ann", Winner: "ann", Played: true},
+			{Round: 1, Home: "bob", Winner: "bob", Played: true},
+			{Round: 1, Home: "cid", Winner: "cid", Played: true},
+			{Round: 1, Home: "dan", Away: "eve"},
+		}
+		for i := range got.Matches {
+			got.Matches[i].Seed = 0
+		}
+		assert.Equal(t, want, got.Matches)
+	})
+
+	t.Run("error when the tournament can't be played", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			format      string
+			maxAttempts int
+			players     []string
+			want        error
+		}{
+			{
+				description: "unknown format",
+				format:      "swiss",
+				maxAttempts: 10,
+				players:     []string{"ann", "bob"},
+				want:        tournament.NewFormatError("swiss"),
+			},
+			{
+				description: "single player",
+				format:      tournament.RoundRobin,
+				maxAttempts: 10,
+				players:     []string{"ann"},
+				want:        tournament.NewPlayersError(),
+			},
+			{
+				description: "same player twice",
+				format:      tournament.RoundRobin,
+				maxAttempts: 10,
+				players:     []string{"ann", "bob", "ann"},
+				want:        tournament.NewPlayersError(),
+			},
+			{
+				description: "same player cased and spaced differently",
+				format:      tournament.RoundRobin,
+				maxAttempts: 10,
+				players:     []string{"bob", "ann", "Bob "},
+				want:        tournament.NewPlayersError(),
+			},
+			{
+				description: "adaptive level",
+				format:      tournament.Elimination,
+				players:     []string{"ann", "bob"},
+				want:        tournament.NewLevelError(),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				_, got := tournament.New(tc.format, "Easy", tc.maxAttempts, tc.players)
+
+				assert.NotNil(t, got)
+				assert.ErrorAs(t, got, &tc.want)
+				assert.Equal(t, tc.want.Error(), got.Error())
+			})
+		}
+	})
+}
+
+func TestIntegrationResultBeats(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			result      tournament.Result
+			other       tournament.Result
+			want        bool
+		}{
+			{
+				description: "found over lost",
+				result:      tournament.Result{Won: true, Attempts: 9},
+				other:       tournament.Result{Attempts: 3},
+				want:        true,
+			},
+			{
+				description: "fewer attempts",
+				result:      tournament.Result{Won: true, Attempts: 3, Time: time.Minute},
+				other:       tournament.Result{Won: true, Attempts: 4},
+				want:        true,
+			},
+			{
+				description: "less time on the same attempts",
+				result:      tournament.Result{Won: true, Attempts: 3},
+				other:       tournament.Result{Won: true, Attempts: 3, Time: time.Second},
+				want:        true,
+			},
+			{
+				description: "perfect tie",
+				result:      tournament.Result{Won: true, Attempts: 3},
+				other:       tournament.Result{Won: true, Attempts: 3},
+				want:        false,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				assert.Equal(t, tc.want, tc.result.Beats(tc.other))
+			})
+		}
+	})
+}
+
+func TestIntegrationTournamentRecord(t *testing.T) {
+	t.Run("play an elimination to its champion", func(t *testing.T) {
+		got, err := tournament.New(tournament.Elimination, "Hard", 3,
+			[]string{"ann", "bob", "cid"},
+		)
+		assert.NoError(t, err)
+
+		index, ok := got.Next()
+		assert.True(t, ok)
+		assert.Equal(t, 1, index)
+		player, _ := got.Matches[index].Next()
+		assert.Equal(t, "bob", player)
+
+		got.Record(index, "bob", tournament.Result{Won: true, Attempts: 3})
+		player, _ = got.Matches[index].Next()
+		assert.Equal(t, "cid", player)
+		got.Record(index, "cid", tournament.Result{Won: true, Attempts: 2})
+
+		assert.Equal(t, "cid", got.Matches[index].Winner)
+		assert.Len(t, got.Matches, 3)
+		final := got.Matches[2]
+		assert.Equal(t, 2, final.Round)
+		assert.Equal(t, "ann", final.Home)
+		assert.Equal(t, "cid", final.Away)
+		assert.False(t, got.Over())
+
+		got.Record(2, "ann", tournament.Result{Won: true, Attempts: 2})
+		got.Record(2, "cid", tournament.Result{Won: true, Attempts: 2})
+
+		assert.True(t, got.Over())
+		assert.Equal(t, "ann", got.Champion())
+	})
+
+	t.Run("rank a round robin by points, then attempts", func(t *testing.T) {
+		got, err := tournament.New(tournament.RoundRobin, "Easy", 10,
+			[]string{"ann", "bob", "cid"},
+		)
+		assert.NoError(t, err)
+
+		attempts := map[string]int{"ann": 4, "bob": 6, "cid": 6}
+		for !got.Over() {
+			index, _ := got.Next()
+			player, _ := got.Matches[index].Next()
+			got.Record(index, player, tournament.Result{
+				Won:      true,
+				Attempts: attempts[player],
+			})
+		}
+
+		standings := got.Standings()
+		assert.Equal(t, tournament.Standing{
+			Player:   "ann",
+			Played:   2,
+			Won:      2,
+			Points:   6,
+			Attempts: 8,
+		}, standings[0])
+		assert.Equal(t, 1, standings[1].Drawn)
+		assert.Equal(t, 1, standings[1].Points)
+		assert.Equal(t, "ann", got.Champion())
+		assert.Contains(t, got.Bracket(), "4 in 0s / 6 in 0s")
+		assert.Contains(t, got.Bracket(), "draw")
+		assert.Contains(t, standings.String(), "| POINTS |")
+	})
+}
+
+func TestIntegrationFileStore(t *testing.T) {
+	t.Run("save and load the tournament", func(t *testing.T) {
+		fileStore := &tournament.FileStore{
+			FilePath: filepath.Join(t.TempDir(), "tournament.json"),
+		}
+
+		_, err := fileStore.Load()
+		want := tournament.NewNoTournamentError()
+		assert.ErrorAs(t, err, &want)
+
+		saved, err := tournament.New(tournament.Elimination, "Easy", 10,
+			[]string{"ann", "bob"},
+		)
+		assert.NoError(t, err)
+		saved.Record(0, "ann", tournament.Result{Won: true, Attempts: 5})
+		assert.NoError(t, fileStore.Save(saved))
+
+		got, err := fileStore.Load()
+
+		assert.NoError(t, err)
+		assert.Equal(t, saved, got)
+	})
+}
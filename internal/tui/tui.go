@@ -0,0 +1,276 @@
+// Package tui renders a full-screen terminal view of a game session using
+// ANSI escape sequences: a number line narrowing as guesses come in, a
+// remaining-attempts gauge, a running timer, a guess history and the
+// leaderboard shown at the end.
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/game"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	saveCursor  = "\x1b7"
+	loadCursor  = "\x1b8"
+	clearLine   = "\x1b[2K"
+
+	// clockRow is the screen row holding the elapsed time, redrawn in place
+	// while the player is typing.
+	clockRow = 5
+)
+
+// DefaultWidth is the number of cells used to draw the number line.
+const DefaultWidth = 50
+
+// Frame holds everything needed to draw one full screen of the game. Min
+// and Max are the range of the number, and Low and High the interval still
+// containing it.
+type Frame struct {
+	Player      string
+	Level       string
+	Min         int
+	Max         int
+	Low         int
+	High        int
+	Attempts    int
+	MaxAttempts int
+	Elapsed     time.Duration
+	Turns       game.Turns
+	Messages    []string
+	Scores      string
+}
+
+// NewFrame builds a frame from the game state, with the messages to show
+// below the history panel.
+func NewFrame(
+	player string,
+	gameState game.GameState,
+	elapsed time.Duration,
+	messages []string,
+) Frame {
+	minimum, maximum := gameState.Bounds()
+	low, high := gameState.PossibleRange()
+
+	return Frame{
+		Player:      player,
+		Level:       gameState.Level,
+		Min:         minimum,
+		Max:         maximum,
+		Low:         low,
+		High:        high,
+		Attempts:    gameState.GetAttempts(),
+		MaxAttempts: gameState.MaxAttempts,
+		Elapsed:     elapsed,
+		Turns:       gameState.Turns,
+		Messages:    messages,
+	}
+}
+
+// Screen draws frames to the writer. GameConfig provides the labels, Width
+// the number line size, and Refresh the clock update interval: zero disables
+// the running clock, which is what tests and non-interactive writers want.
+// Everything else written to the writer while the clock runs, such as the
+// prompts, must go through the screen so that it doesn't interleave with
+// the clock.
+type Screen struct {
+	Writer     io.Writer
+	GameConfig map[string]string
+	Width      int
+	Refresh    time.Duration
+
+	mu   sync.Mutex
+	last Frame
+	stop chan struct{}
+}
+
+// Draw clears the screen and renders the frame, remembering it so the
+// clock and the leaderboard can be drawn on top of it later.
+func (s *Screen) Draw(f Frame) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.last = f
+	fmt.Fprint(s.Writer, clearScreen+s.render(f))
+}
+
+// Write writes p to the writer, waiting for the clock to be drawn.
+func (s *Screen) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.Writer.Write(p)
+}
+
+// ShowScores redraws the last frame with the leaderboard below it.
+func (s *Screen) ShowScores(scores string) {
+	s.mu.Lock()
+	f := s.last
+	s.mu.Unlock()
+
+	f.Scores = scores
+	s.Draw(f)
+}
+
+// StartClock redraws the elapsed time line every Refresh interval until
+// StopClock is called. It does nothing when Refresh is zero.
+func (s *Screen) StartClock(elapsed func() time.Duration) {
+	if s.Refresh <= 0 {
+		return
+	}
+
+	s.StopClock()
+	stop := make(chan struct{})
+	s.mu.Lock()
+	s.stop = stop
+	s.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(s.Refresh)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				s.drawClock(stop, elapsed())
+			}
+		}
+	}()
+}
+
+// StopClock stops the running clock, if any, returning once the clock is
+// no longer drawn.
+func (s *Screen) StopClock() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop == nil {
+		return
+	}
+	close(s.stop)
+	s.stop = nil
+}
+
+func (s *Screen) drawClock(stop chan struct{}, elapsed time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-stop:
+		return
+	default:
+	}
+
+	fmt.Fprintf(s.Writer, "%s\x1b[%d;1H%s%s%s",
+		saveCursor,
+		clockRow,
+		clearLine,
+		fmt.Sprintf(s.GameConfig["tui_time"], elapsed),
+		loadCursor,
+	)
+}
+
+func (s *Screen) render(f Frame) string {
+	width := s.Width
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	minimum, maximum := f.Min, f.Max
+	if minimum == 0 && maximum == 0 {
+		minimum, maximum = game.MinNumber, game.MaxNumber
+	}
+
+	lines := []string{
+		fmt.Sprintf(s.GameConfig["tui_title"], f.Player, f.Level),
+		"",
+		fmt.Sprintf(s.GameConfig["tui_range"],
+			minimum,
+			RangeLine(minimum, maximum, f.Low, f.High, width),
+			maximum,
+			f.Low,
+			f.High,
+		),
+		fmt.Sprintf(s.GameConfig["tui_attempts"],
+			Gauge(f.MaxAttempts-f.Attempts, f.MaxAttempts),
+			f.MaxAttempts-f.Attempts,
+			f.MaxAttempts,
+		),
+		fmt.Sprintf(s.GameConfig["tui_time"], f.Elapsed),
+		"",
+		s.GameConfig["tui_history"],
+	}
+
+	for i, turn := range f.Turns {
+		lines = append(lines, fmt.Sprintf(s.GameConfig["tui_turn"],
+			i+1,
+			turn.GuessNumber,
+			outcomeSymbol(turn),
+		))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, strings.Join(f.Messages, ""))
+
+	if f.Scores != "" {
+		lines = append(lines, "", f.Scores)
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// RangeLine draws the range between minimum and maximum as width cells,
+// filling the cells that still overlap the possible interval between low
+// and high.
+func RangeLine(minimum, maximum, low, high, width int) string {
+	span := maximum - minimum + 1
+
+	var builder strings.Builder
+	for i := 0; i < width; i++ {
+		cellLow := minimum + i*span/width
+		cellHigh := minimum + (i+1)*span/width - 1
+		if cellHigh < cellLow {
+			cellHigh = cellLow
+		}
+
+		if cellHigh >= low && cellLow <= high {
+			builder.WriteString("█")
+		} else {
+			builder.WriteString("·")
+		}
+	}
+
+	return builder.String()
+}
+
+// Gauge draws the remaining attempts as filled blocks out of total.
+func Gauge(remaining, total int) string {
+	remaining = max(min(remaining, total), 0)
+
+	return "[" + strings.Repeat("■", remaining) +
+		strings.Repeat("□", total-remaining) + "]"
+}
+
+func outcomeSymbol(turn game.Turn) string {
+	if turn.Outcome == nil {
+		return ""
+	}
+
+	switch *turn.Outcome {
+	case game.Greater:
+		return "↑"
+	case game.Less:
+		return "↓"
+	case game.Mismatch:
+		return fmt.Sprintf("%dB%dC", turn.Bulls, turn.Cows)
+	default:
+		return "✓"
+	}
+}
@@ -0,0 +1,210 @@
+package tui_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-number-guessing-game/internal/game"
+	"github.com/go-number-guessing-game/internal/tui"
+	"github.com/stretchr/testify/assert"
+)
+
+var gameConfig = map[string]string{
+	"tui_title":    "Game - %s (%s)",
+	"tui_range":    "Range %d %s %d (%d-%d)",
+	"tui_attempts": "Attempts %s %d/%d left",
+	"tui_time":     "Time %v",
+	"tui_history":  "History",
+	"tui_turn":     "%d. %d %s",
+}
+
+func TestUnitRangeLine(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			low         int
+			high        int
+			width       int
+			want        string
+		}{
+			{
+				description: "filled line for the full range",
+				low:         1,
+				high:        100,
+				width:       10,
+				want:        strings.Repeat("█", 10),
+			},
+			{
+				description: "narrowed line for the upper half",
+				low:         51,
+				high:        100,
+				width:       10,
+				want:        strings.Repeat("·", 5) + strings.Repeat("█", 5),
+			},
+			{
+				description: "single cell for a found number",
+				low:         50,
+				high:        50,
+				width:       10,
+				want: strings.Repeat("·", 4) + "█" +
+					strings.Repeat("·", 5),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got := tui.RangeLine(game.MinNumber, game.MaxNumber, tc.low, tc.high, tc.width)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("scale to the range of the round", func(t *testing.T) {
+		got := tui.RangeLine(1, 200, 101, 200, 10)
+		assert.Equal(t, strings.Repeat("·", 5)+strings.Repeat("█", 5), got)
+	})
+}
+
+func TestUnitGauge(t *testing.T) {
+	t.Run("return", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			remaining   int
+			total       int
+			want        string
+		}{
+			{
+				description: "full gauge",
+				remaining:   3,
+				total:       3,
+				want:        "[■■■]",
+			},
+			{
+				description: "partial gauge",
+				remaining:   2,
+				total:       5,
+				want:        "[■■□□□]",
+			},
+			{
+				description: "empty gauge when negative",
+				remaining:   -1,
+				total:       3,
+				want:        "[□□□]",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.description, func(t *testing.T) {
+				got := tui.Gauge(tc.remaining, tc.total)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
+
+func TestUnitScreenDraw(t *testing.T) {
+	gameState := game.GameState{
+		Level:        "Hard",
+		MaxAttempts:  3,
+		RandomNumber: 50,
+	}
+	assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 25}))
+	assert.NoError(t, gameState.PlayTurn(game.Turn{GuessNumber: 75}))
+
+	t.Run("draw frame", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		screen := &tui.Screen{Writer: buffer, GameConfig: gameConfig, Width: 10}
+
+		screen.Draw(tui.NewFrame("test", gameState, 12*time.Second,
+			[]string{"message"},
+		))
+		got := buffer.String()
+
+		assert.True(t, strings.HasPrefix(got, "\x1b[H\x1b[2J"))
+		assert.Contains(t, got, "Game - test (Hard)")
+		assert.Contains(t, got, fmt.Sprintf("Range 1 %s 100 (26-74)",
+			tui.RangeLine(game.MinNumber, game.MaxNumber, 26, 74, 10),
+		))
+		assert.Contains(t, got, "Attempts [■□□] 1/3 left")
+		assert.Contains(t, got, "Time 12s")
+		assert.Contains(t, got, "1. 25 ↑")
+		assert.Contains(t, got, "2. 75 ↓")
+		assert.Contains(t, got, "message")
+	})
+
+	t.Run("redraw last frame with scores", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		screen := &tui.Screen{Writer: buffer, GameConfig: gameConfig, Width: 10}
+
+		screen.Draw(tui.NewFrame("test", gameState, 0, []string{"message"}))
+		buffer.Reset()
+		screen.ShowScores("scores table")
+		got := buffer.String()
+
+		assert.Contains(t, got, "Game - test (Hard)")
+		assert.Contains(t, got, "message")
+		assert.Contains(t, got, "scores table")
+	})
+}
+
+func TestUnitScreenClock(t *testing.T) {
+	t.Run("serialize the clock with the other writes", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		screen := &tui.Screen{
+			Writer:     buffer,
+			GameConfig: gameConfig,
+			Refresh:    time.Millisecond,
+		}
+
+		screen.StartClock(func() time.Duration { return time.Second })
+		for i := 0; i < 20; i++ {
+			fmt.Fprint(screen, "Enter your guess: ")
+			time.Sleep(time.Millisecond)
+		}
+		screen.StopClock()
+		got := buffer.String()
+
+		assert.Contains(t, got, "Time 1s")
+		assert.Equal(t, 20, strings.Count(got, "Enter your guess: "))
+	})
+
+	t.Run("stop drawing the clock", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		screen := &tui.Screen{
+			Writer:     buffer,
+			GameConfig: gameConfig,
+			Refresh:    time.Millisecond,
+		}
+
+		screen.StartClock(func() time.Duration { return time.Second })
+		time.Sleep(5 * time.Millisecond)
+		screen.StopClock()
+		stopped := buffer.Len()
+		time.Sleep(5 * time.Millisecond)
+
+		assert.Equal(t, stopped, buffer.Len())
+	})
+	t.Run("stop the clock from another goroutine", func(t *testing.T) {
+		screen := &tui.Screen{
+			Writer:     &bytes.Buffer{},
+			GameConfig: gameConfig,
+			Refresh:    time.Millisecond,
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 20; i++ {
+				screen.StopClock()
+			}
+		}()
+		for i := 0; i < 20; i++ {
+			screen.StartClock(func() time.Duration { return time.Second })
+		}
+		<-done
+		screen.StopClock()
+	})
+}